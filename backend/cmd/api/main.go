@@ -3,10 +3,19 @@ package main
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/zebraApp/internal/auth"
 	"github.com/zebraApp/internal/config"
+	"github.com/zebraApp/internal/controllers"
+	"github.com/zebraApp/internal/database"
+	"github.com/zebraApp/internal/mailer"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/pricing"
+	"github.com/zebraApp/internal/services"
+	"github.com/zebraApp/internal/validation"
 )
 
 func main() {
@@ -16,8 +25,81 @@ func main() {
 		log.Fatalf("設定ファイルの読み込みに失敗しました: %v", err)
 	}
 
+	// データベースに接続
+	db, err := database.Open(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("データベースの初期化に失敗しました: %v", err)
+	}
+	defer db.Close()
+
+	// メール送信の初期化
+	mail, err := mailer.New(cfg.MailDriver, cfg.MailFrom, cfg.MailOutputDir)
+	if err != nil {
+		log.Fatalf("メール送信の初期化に失敗しました: %v", err)
+	}
+
+	// キャンセル料規定（予約タイプごと。仮予約の設定が空の場合はキャンセル料なし）
+	cancellationTiers := map[models.BookingType][]services.CancellationFeeTier{}
+	for bookingType, setting := range map[models.BookingType]string{
+		models.BookingTypeConfirmed: cfg.CancellationFeeTiers,
+		models.BookingTypeTemporary: cfg.CancellationFeeTiersTemporary,
+	} {
+		if bookingType == models.BookingTypeTemporary && setting == "" {
+			continue
+		}
+		tiers, err := services.ParseCancellationFeeTiers(setting)
+		if err != nil {
+			log.Fatalf("キャンセル料設定（%s）の読み込みに失敗しました: %v", bookingType, err)
+		}
+		cancellationTiers[bookingType] = tiers
+	}
+	cancellationPolicy := services.NewCancellationPolicy(cancellationTiers, cfg.Location)
+
+	// 料金計算
+	taxRounding, err := pricing.ParseRoundingMode(cfg.TaxRounding)
+	if err != nil {
+		log.Fatalf("料金設定の読み込みに失敗しました: %v", err)
+	}
+	ratePlanService := services.NewRatePlanService(db.Gorm)
+	holidayService := services.NewHolidayService(db.Gorm)
+	pricingEngine := pricing.NewEngine(pricing.Config{
+		HourlyRate:   cfg.HourlyRate,
+		MinimumHours: cfg.MinimumHours,
+		TaxRate:      cfg.TaxRate,
+		TaxRounding:  taxRounding,
+		Location:     cfg.Location,
+	}, ratePlanService, holidayService)
+
+	// サービスとコントローラーの初期化
+	businessHoursService := services.NewBusinessHoursService(db.Gorm, cfg.Location, holidayService)
+	bookingValidator := services.NewBookingValidator(db.Gorm, validation.Rules{
+		MinimumDuration:      time.Duration(cfg.MinBookingMinutes) * time.Minute,
+		IntervalMinutes:      cfg.BookingIntervalMinutes,
+		MaxAdvanceDays:       cfg.MaxAdvanceBookingDays,
+		MaxTemporaryBookings: cfg.MaxTemporaryBookings,
+		Location:             cfg.Location,
+	}, businessHoursService)
+	calendarService := services.NewCalendarService(db.SQL, pricingEngine, businessHoursService, holidayService, cfg.Location)
+	adminBookingService := services.NewAdminBookingService(db.Gorm, cancellationPolicy, pricingEngine, bookingValidator, cfg.Location)
+	authService := services.NewAuthService(db.Gorm, cfg.JWTSecret, cfg.JWTExpiration, cfg.RefreshTokenExpiration)
+	passwordResetService := services.NewPasswordResetService(db.Gorm, mail, cfg.AppBaseURL, cfg.PasswordResetExpiration)
+	userBookingService := services.NewUserBookingService(db.Gorm, cancellationPolicy, pricingEngine, bookingValidator)
+	changeRequestService := services.NewBookingChangeRequestService(db.Gorm, cancellationPolicy, pricingEngine, bookingValidator)
+
+	calendarController := controllers.NewCalendarController(calendarService, cfg.Location)
+	adminBookingController := controllers.NewAdminBookingController(adminBookingService)
+	authController := controllers.NewAuthController(authService)
+	passwordResetController := controllers.NewPasswordResetController(passwordResetService)
+	userBookingController := controllers.NewUserBookingController(userBookingService)
+	changeRequestController := controllers.NewBookingChangeRequestController(changeRequestService)
+	ratePlanController := controllers.NewRatePlanController(ratePlanService)
+	businessHoursController := controllers.NewBusinessHoursController(businessHoursService)
+	holidayController := controllers.NewHolidayController(holidayService)
+
 	// Echoインスタンスを作成
 	e := echo.New()
+	// エラーレスポンスは共通形式（code・message・details）で返す
+	e.HTTPErrorHandler = controllers.HTTPErrorHandler
 
 	// ミドルウェアの設定
 	e.Use(middleware.Logger())
@@ -34,7 +116,6 @@ func main() {
 	// APIルートグループ
 	api := e.Group("/api")
 
-	// ルートの設定（後で実際のコントローラーに置き換え）
 	api.GET("/", func(c echo.Context) error {
 		return c.JSON(200, map[string]string{
 			"message": "撮影スタジオ予約管理APIへようこそ",
@@ -42,6 +123,66 @@ func main() {
 		})
 	})
 
+	// 認証
+	authGroup := api.Group("/auth")
+	authGroup.POST("/register", authController.Register)
+	authGroup.POST("/login", authController.Login)
+	authGroup.POST("/refresh", authController.Refresh)
+	authGroup.POST("/logout", authController.Logout)
+	authGroup.POST("/forgot-password", passwordResetController.ForgotPassword)
+	authGroup.POST("/reset-password", passwordResetController.ResetPassword)
+
+	// カレンダー
+	calendar := api.Group("/calendar", auth.OptionalJWT(cfg.JWTSecret))
+	calendar.GET("/events", calendarController.GetEvents)
+	calendar.GET("/availability", calendarController.GetAvailability)
+	calendar.GET("/search", calendarController.SearchWindows)
+	calendar.GET("/price-quote", calendarController.GetPriceQuote)
+
+	// ユーザー予約
+	bookings := api.Group("/bookings", auth.JWT(cfg.JWTSecret))
+	bookings.POST("", userBookingController.CreateBooking)
+	bookings.GET("", userBookingController.GetBookings)
+	bookings.GET("/history", userBookingController.GetBookings)
+	bookings.GET("/:id", userBookingController.GetBookingByID)
+	bookings.POST("/:id/cancel", userBookingController.CancelBooking)
+	bookings.POST("/:id/confirm", userBookingController.ConfirmBooking)
+	bookings.GET("/:id/cancellation-quote", userBookingController.QuoteCancellation)
+	bookings.POST("/:id/change-requests", changeRequestController.RequestChange)
+	bookings.GET("/:id/change-requests", changeRequestController.GetBookingChangeRequests)
+
+	// 管理者用予約管理
+	admin := api.Group("/admin", auth.JWT(cfg.JWTSecret), auth.RequireAdmin())
+	admin.GET("/bookings", adminBookingController.GetBookings)
+	admin.POST("/bookings", adminBookingController.CreateBooking)
+	admin.GET("/bookings/availability", adminBookingController.CheckAvailability)
+	admin.POST("/bookings/bulk", adminBookingController.BulkBookingAction)
+	admin.POST("/booking-series", adminBookingController.CreateBookingSeries)
+	admin.GET("/booking-series/:id", adminBookingController.GetBookingSeries)
+	admin.PUT("/booking-series/:id", adminBookingController.UpdateBookingSeries)
+	admin.POST("/booking-series/:id/cancel", adminBookingController.CancelBookingSeries)
+	admin.GET("/bookings/:id", adminBookingController.GetBookingByID)
+	admin.PUT("/bookings/:id", adminBookingController.UpdateBooking)
+	admin.DELETE("/bookings/:id", adminBookingController.DeleteBooking)
+	admin.GET("/bookings/:id/cancellation-quote", adminBookingController.QuoteCancellation)
+	admin.POST("/bookings/:id/approve", adminBookingController.ApproveBooking)
+	admin.POST("/bookings/:id/reject", adminBookingController.RejectBooking)
+	admin.GET("/change-requests", changeRequestController.ListChangeRequests)
+	admin.POST("/change-requests/:id/accept", changeRequestController.AcceptChangeRequest)
+	admin.POST("/change-requests/:id/decline", changeRequestController.DeclineChangeRequest)
+	admin.GET("/users/search", adminBookingController.SearchUsers)
+	admin.GET("/rate-plans", ratePlanController.GetRatePlans)
+	admin.POST("/rate-plans", ratePlanController.CreateRatePlan)
+	admin.PUT("/rate-plans/:id", ratePlanController.UpdateRatePlan)
+	admin.DELETE("/rate-plans/:id", ratePlanController.DeleteRatePlan)
+	admin.GET("/business-hours", businessHoursController.GetBusinessHours)
+	admin.POST("/business-hours", businessHoursController.CreateBusinessHours)
+	admin.PUT("/business-hours/:id", businessHoursController.UpdateBusinessHours)
+	admin.DELETE("/business-hours/:id", businessHoursController.DeleteBusinessHours)
+	admin.GET("/holidays", holidayController.GetHolidays)
+	admin.POST("/holidays/import", holidayController.ImportHolidays)
+	admin.DELETE("/holidays/:id", holidayController.DeleteHoliday)
+
 	// サーバーの起動
 	port := cfg.ServerPort
 	if port == "" {
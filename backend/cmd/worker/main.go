@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os/signal"
+	"syscall"
+
+	"github.com/zebraApp/internal/config"
+	"github.com/zebraApp/internal/database"
+	"github.com/zebraApp/internal/services"
+	"github.com/zebraApp/internal/worker"
+)
+
+// 仮予約の確認期限切れを処理するバックグラウンドワーカー
+// セルフホスト環境でEventBridge + Lambdaの代わりに使用する
+func main() {
+	// 環境変数から設定を読み込む
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("設定ファイルの読み込みに失敗しました: %v", err)
+	}
+
+	// データベースに接続
+	db, err := database.Open(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("データベースの初期化に失敗しました: %v", err)
+	}
+	defer db.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	expiryService := services.NewBookingExpiryService(db.Gorm)
+	expiryWorker := worker.NewExpiryWorker(expiryService, cfg.BookingExpiryInterval)
+
+	log.Printf("ワーカーを起動します（実行間隔: %s）", cfg.BookingExpiryInterval)
+	expiryWorker.Run(ctx)
+	log.Printf("ワーカーを停止しました")
+}
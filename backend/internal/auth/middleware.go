@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ContextKey は認証済みユーザーを格納するecho.Contextのキー
+const ContextKey = "user"
+
+// JWT は必須認証のミドルウェア
+// Authorizationヘッダーのベアラートークンを検証し、Principalをコンテキストに設定する
+func JWT(secret string) echo.MiddlewareFunc {
+	return authenticate([]byte(secret), false)
+}
+
+// OptionalJWT はトークンがあれば検証し、なければ匿名のまま通すミドルウェア
+// トークンが付与されているが不正な場合は401を返す
+func OptionalJWT(secret string) echo.MiddlewareFunc {
+	return authenticate([]byte(secret), true)
+}
+
+// RequireAdmin は管理者以外のアクセスを拒否するミドルウェア
+// JWTミドルウェアの後に配置する必要がある
+func RequireAdmin() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			principal := PrincipalFromContext(ctx)
+			if principal == nil {
+				return unauthorized(ctx, "UNAUTHORIZED")
+			}
+			if !principal.IsAdmin {
+				return echo.NewHTTPError(http.StatusForbidden, "FORBIDDEN")
+			}
+			return next(ctx)
+		}
+	}
+}
+
+// PrincipalFromContext はコンテキストから認証済みユーザーを取得する
+// 未認証の場合はnilを返す
+func PrincipalFromContext(ctx echo.Context) *Principal {
+	principal, _ := ctx.Get(ContextKey).(*Principal)
+	return principal
+}
+
+func authenticate(secret []byte, optional bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			header := ctx.Request().Header.Get(echo.HeaderAuthorization)
+			if header == "" {
+				if optional {
+					return next(ctx)
+				}
+				return unauthorized(ctx, "UNAUTHORIZED")
+			}
+
+			tokenString, ok := bearerToken(header)
+			if !ok {
+				return unauthorized(ctx, "INVALID_TOKEN")
+			}
+
+			claims, err := ParseAccessToken(tokenString, secret)
+			if err != nil {
+				if errors.Is(err, ErrTokenExpired) {
+					return unauthorized(ctx, "TOKEN_EXPIRED")
+				}
+				return unauthorized(ctx, "INVALID_TOKEN")
+			}
+
+			ctx.Set(ContextKey, claims.Principal())
+			return next(ctx)
+		}
+	}
+}
+
+// bearerToken は "Bearer <token>" 形式のヘッダーからトークンを取り出す
+func bearerToken(header string) (string, bool) {
+	const prefix = "Bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(header[len(prefix):]), true
+}
+
+// unauthorized は401エラーを返す
+// レスポンスの本文は共通のエラーハンドラーがエラーコード（メッセージに設定）から作成する
+func unauthorized(ctx echo.Context, code string) error {
+	ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="api"`)
+	return echo.NewHTTPError(http.StatusUnauthorized, code)
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrTokenExpired はトークンの有効期限切れを表す
+	ErrTokenExpired = errors.New("token expired")
+	// ErrInvalidToken は署名不正・形式不正なトークンを表す
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// Claims はアクセストークンに含まれるクレーム
+type Claims struct {
+	Email   string `json:"email"`
+	Name    string `json:"name,omitempty"`
+	IsAdmin bool   `json:"isAdmin"`
+	jwt.RegisteredClaims
+}
+
+// Principal は認証済みユーザーを表す
+type Principal struct {
+	ID      string
+	Email   string
+	IsAdmin bool
+}
+
+// IssueAccessToken はユーザー情報からHS256で署名したアクセストークンを発行する
+func IssueAccessToken(principal Principal, name string, secret []byte, ttl time.Duration, now time.Time) (string, error) {
+	claims := Claims{
+		Email:   principal.Email,
+		Name:    name,
+		IsAdmin: principal.IsAdmin,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   principal.ID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now.Add(-2 * time.Second)), // 時刻ずれの猶予
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			ID:        uuid.NewString(),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+	if err != nil {
+		return "", fmt.Errorf("アクセストークンの署名に失敗しました: %w", err)
+	}
+	return signed, nil
+}
+
+// ParseAccessToken はHS256で署名されたアクセストークンを検証し、クレームを返す
+func ParseAccessToken(tokenString string, secret []byte) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrTokenExpired
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: subject is empty", ErrInvalidToken)
+	}
+
+	return claims, nil
+}
+
+// Principal はクレームから認証済みユーザー情報を生成する
+func (c *Claims) Principal() *Principal {
+	return &Principal{
+		ID:      c.Subject,
+		Email:   c.Email,
+		IsAdmin: c.IsAdmin,
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
+	// 実行環境にタイムゾーンデータベースがなくてもSTUDIO_TIMEZONEを読み込めるようにする
+	_ "time/tzdata"
 )
 
 // Config はアプリケーション設定を保持する構造体
@@ -11,6 +14,10 @@ type Config struct {
 	// サーバー設定
 	ServerPort string
 
+	// スタジオのタイムゾーン（日付のみの指定・営業時間・料金帯・キャンセル料の日数の判定に使う）
+	Timezone string
+	Location *time.Location
+
 	// データベース設定
 	DBHost      string
 	DBPort      int
@@ -24,7 +31,40 @@ type Config struct {
 	RedisPort int
 
 	// JWT設定
-	JWTSecret string
+	JWTSecret              string
+	JWTExpiration          time.Duration
+	RefreshTokenExpiration time.Duration
+
+	// パスワードリセット設定
+	PasswordResetExpiration time.Duration
+
+	// メール設定
+	MailDriver    string
+	MailFrom      string
+	MailOutputDir string
+
+	// フロントエンドのURL（メール内リンクに使用）
+	AppBaseURL string
+
+	// ワーカー設定
+	BookingExpiryInterval time.Duration
+
+	// キャンセル料設定（予約タイプごと、"日数:料率"のカンマ区切り）
+	// 仮予約は空の場合キャンセル料なし
+	CancellationFeeTiers          string
+	CancellationFeeTiersTemporary string
+
+	// 料金設定
+	HourlyRate   int
+	MinimumHours float64
+	TaxRate      float64
+	TaxRounding  string
+
+	// 予約の業務ルール設定
+	MinBookingMinutes      int
+	BookingIntervalMinutes int
+	MaxAdvanceBookingDays  int
+	MaxTemporaryBookings   int
 }
 
 // LoadConfig は環境変数から設定を読み込む
@@ -34,6 +74,14 @@ func LoadConfig() (*Config, error) {
 	// サーバー設定
 	cfg.ServerPort = getEnv("SERVER_PORT", "8080")
 
+	// スタジオのタイムゾーン
+	cfg.Timezone = getEnv("STUDIO_TIMEZONE", "Asia/Tokyo")
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("STUDIO_TIMEZONEの形式が正しくありません: %w", err)
+	}
+	cfg.Location = location
+
 	// データベース設定
 	cfg.DBHost = getEnv("DB_HOST", "postgres")
 	dbPort, _ := strconv.Atoi(getEnv("DB_PORT", "5432"))
@@ -49,6 +97,84 @@ func LoadConfig() (*Config, error) {
 
 	// JWT設定
 	cfg.JWTSecret = getEnv("JWT_SECRET", "devjwtsecretkey")
+	jwtExpiration, err := time.ParseDuration(getEnv("JWT_EXPIRATION", "1h"))
+	if err != nil {
+		return nil, fmt.Errorf("JWT_EXPIRATIONの形式が正しくありません: %w", err)
+	}
+	cfg.JWTExpiration = jwtExpiration
+	refreshExpiration, err := time.ParseDuration(getEnv("REFRESH_TOKEN_EXPIRATION", "720h"))
+	if err != nil {
+		return nil, fmt.Errorf("REFRESH_TOKEN_EXPIRATIONの形式が正しくありません: %w", err)
+	}
+	cfg.RefreshTokenExpiration = refreshExpiration
+
+	// パスワードリセット設定
+	resetExpiration, err := time.ParseDuration(getEnv("PASSWORD_RESET_EXPIRATION", "1h"))
+	if err != nil {
+		return nil, fmt.Errorf("PASSWORD_RESET_EXPIRATIONの形式が正しくありません: %w", err)
+	}
+	cfg.PasswordResetExpiration = resetExpiration
+
+	// メール設定
+	cfg.MailDriver = getEnv("MAIL_DRIVER", "log")
+	cfg.MailFrom = getEnv("MAIL_FROM", "no-reply@studio-booking.example.com")
+	cfg.MailOutputDir = getEnv("MAIL_OUTPUT_DIR", "tmp/mail")
+
+	cfg.AppBaseURL = getEnv("APP_BASE_URL", "http://localhost:3000")
+
+	// ワーカー設定
+	expiryInterval, err := time.ParseDuration(getEnv("BOOKING_EXPIRY_INTERVAL", "5m"))
+	if err != nil {
+		return nil, fmt.Errorf("BOOKING_EXPIRY_INTERVALの形式が正しくありません: %w", err)
+	}
+	if expiryInterval <= 0 {
+		return nil, fmt.Errorf("BOOKING_EXPIRY_INTERVALは正の値である必要があります")
+	}
+	cfg.BookingExpiryInterval = expiryInterval
+
+	// キャンセル料設定
+	cfg.CancellationFeeTiers = getEnv("CANCELLATION_FEE_TIERS", "6:0,4:50,1:80,0:100")
+	cfg.CancellationFeeTiersTemporary = getEnv("CANCELLATION_FEE_TIERS_TEMPORARY", "")
+
+	// 料金設定
+	hourlyRate, err := strconv.Atoi(getEnv("HOURLY_RATE", "5000"))
+	if err != nil || hourlyRate < 0 {
+		return nil, fmt.Errorf("HOURLY_RATEの形式が正しくありません")
+	}
+	cfg.HourlyRate = hourlyRate
+	minimumHours, err := strconv.ParseFloat(getEnv("MINIMUM_HOURS", "2"), 64)
+	if err != nil || minimumHours < 0 {
+		return nil, fmt.Errorf("MINIMUM_HOURSの形式が正しくありません")
+	}
+	cfg.MinimumHours = minimumHours
+	taxRate, err := strconv.ParseFloat(getEnv("TAX_RATE", "0.10"), 64)
+	if err != nil || taxRate < 0 || taxRate >= 1 {
+		return nil, fmt.Errorf("TAX_RATEの形式が正しくありません（例: 0.10）")
+	}
+	cfg.TaxRate = taxRate
+	cfg.TaxRounding = getEnv("TAX_ROUNDING", "floor")
+
+	// 予約の業務ルール設定
+	minBookingMinutes, err := strconv.Atoi(getEnv("MIN_BOOKING_MINUTES", "120"))
+	if err != nil || minBookingMinutes < 0 {
+		return nil, fmt.Errorf("MIN_BOOKING_MINUTESの形式が正しくありません")
+	}
+	cfg.MinBookingMinutes = minBookingMinutes
+	intervalMinutes, err := strconv.Atoi(getEnv("BOOKING_INTERVAL_MINUTES", "60"))
+	if err != nil || intervalMinutes < 30 || (24*60)%intervalMinutes != 0 {
+		return nil, fmt.Errorf("BOOKING_INTERVAL_MINUTESは30分以上で1日を割り切れる値である必要があります")
+	}
+	cfg.BookingIntervalMinutes = intervalMinutes
+	maxAdvanceDays, err := strconv.Atoi(getEnv("MAX_ADVANCE_BOOKING_DAYS", "180"))
+	if err != nil || maxAdvanceDays < 0 {
+		return nil, fmt.Errorf("MAX_ADVANCE_BOOKING_DAYSの形式が正しくありません")
+	}
+	cfg.MaxAdvanceBookingDays = maxAdvanceDays
+	maxTemporaryBookings, err := strconv.Atoi(getEnv("MAX_TEMPORARY_BOOKINGS", "10"))
+	if err != nil || maxTemporaryBookings < 0 {
+		return nil, fmt.Errorf("MAX_TEMPORARY_BOOKINGSの形式が正しくありません")
+	}
+	cfg.MaxTemporaryBookings = maxTemporaryBookings
 
 	// データベースURL組み立て
 	cfg.DatabaseURL = getEnv("DATABASE_URL",
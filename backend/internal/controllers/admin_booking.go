@@ -5,7 +5,11 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/auth"
+	"github.com/zebraApp/internal/i18n"
+	"github.com/zebraApp/internal/services"
 )
 
 type AdminBookingController struct {
@@ -19,97 +23,35 @@ type AdminBookingService interface {
 	GetBookingByID(bookingID string) (*BookingResponse, error)
 	DeleteBooking(bookingID string, adminID string) error
 	SearchUsers(query string, limit int) ([]UserSearchResult, error)
-	CheckAvailability(startTime, endTime time.Time, excludeBookingID string) (bool, error)
+	CheckAvailability(startTime, endTime time.Time, excludeBookingID string) (*AvailabilityCheckResponse, error)
+	QuoteCancellation(bookingID string) (*CancellationQuote, error)
+	ApproveBooking(bookingID string, req BookingDecisionRequest, adminID string) (*BookingResponse, error)
+	RejectBooking(bookingID string, req BookingDecisionRequest, adminID string) (*BookingResponse, error)
+	BulkBookingAction(req BulkBookingActionRequest, adminID string) (*BulkBookingActionResponse, error)
+	CreateBookingSeries(req CreateBookingSeriesRequest, adminID string) (*BookingSeriesResult, error)
+	GetBookingSeries(seriesID string) (*BookingSeriesResponse, error)
+	UpdateBookingSeries(seriesID string, req UpdateBookingSeriesRequest, adminID string) (*BookingSeriesResult, error)
+	CancelBookingSeries(seriesID string, req CancelBookingSeriesRequest, adminID string) (*BookingSeriesResult, error)
 }
 
-type CreateBookingRequest struct {
-	UserID      string    `json:"userId" validate:"required"`
-	UserEmail   string    `json:"userEmail,omitempty"`
-	UserName    string    `json:"userName,omitempty"`
-	StartTime   time.Time `json:"startTime" validate:"required"`
-	EndTime     time.Time `json:"endTime" validate:"required"`
-	BookingType string    `json:"bookingType" validate:"required,oneof=temporary confirmed"`
-	Purpose     string    `json:"purpose,omitempty"`
-	Notes       string    `json:"notes,omitempty"`
-	OptionIDs   []string  `json:"optionIds,omitempty"`
-	Status      string    `json:"status,omitempty"` // 管理者が作成時にステータスを指定可能
-}
-
-type UpdateBookingRequest struct {
-	StartTime   *time.Time `json:"startTime,omitempty"`
-	EndTime     *time.Time `json:"endTime,omitempty"`
-	BookingType *string    `json:"bookingType,omitempty"`
-	Purpose     *string    `json:"purpose,omitempty"`
-	Notes       *string    `json:"notes,omitempty"`
-	Status      *string    `json:"status,omitempty"`
-	OptionIDs   []string   `json:"optionIds,omitempty"`
-}
-
-type BookingResponse struct {
-	ID                      string                  `json:"id"`
-	UserID                  string                  `json:"userId"`
-	UserName                string                  `json:"userName"`
-	UserEmail               string                  `json:"userEmail"`
-	StartTime               time.Time               `json:"startTime"`
-	EndTime                 time.Time               `json:"endTime"`
-	Status                  string                  `json:"status"`
-	BookingType             string                  `json:"bookingType"`
-	Purpose                 string                  `json:"purpose"`
-	Notes                   string                  `json:"notes,omitempty"`
-	TotalAmountIncludingTax int                     `json:"totalAmountIncludingTax"`
-	CreatedAt               time.Time               `json:"createdAt"`
-	UpdatedAt               time.Time               `json:"updatedAt"`
-	CreatedBy               string                  `json:"createdBy,omitempty"` // 管理者が作成した場合
-	UpdatedBy               string                  `json:"updatedBy,omitempty"`
-	Options                 []BookingOptionResponse `json:"options,omitempty"`
-}
-
-type BookingOptionResponse struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Price    int    `json:"price"`
-	Quantity int    `json:"quantity"`
-}
-
-type BookingFilters struct {
-	Status      string `query:"status"`
-	BookingType string `query:"bookingType"`
-	UserID      string `query:"userId"`
-	StartDate   string `query:"startDate"`
-	EndDate     string `query:"endDate"`
-	Search      string `query:"search"`
-	SortBy      string `query:"sortBy"`
-	SortOrder   string `query:"sortOrder"`
-}
-
-type BookingListResponse struct {
-	Bookings    []BookingResponse `json:"bookings"`
-	TotalCount  int               `json:"totalCount"`
-	Page        int               `json:"page"`
-	Limit       int               `json:"limit"`
-	HasNextPage bool              `json:"hasNextPage"`
-}
-
-type UserSearchResult struct {
-	ID       string `json:"id"`
-	Email    string `json:"email"`
-	FullName string `json:"fullName"`
-	Phone    string `json:"phone,omitempty"`
-}
-
-type AvailabilityCheckResponse struct {
-	Available bool                 `json:"available"`
-	Conflicts []ConflictingBooking `json:"conflicts,omitempty"`
-	Message   string               `json:"message,omitempty"`
-}
-
-type ConflictingBooking struct {
-	ID        string    `json:"id"`
-	StartTime time.Time `json:"startTime"`
-	EndTime   time.Time `json:"endTime"`
-	UserName  string    `json:"userName"`
-	Status    string    `json:"status"`
-}
+// リクエスト・レスポンス型はサービス層の定義を共有する
+type (
+	CreateBookingRequest  = services.CreateBookingRequest
+	UpdateBookingRequest  = services.UpdateBookingRequest
+	BookingResponse       = services.BookingResponse
+	BookingOptionResponse = services.BookingOptionResponse
+	BookingFilters        = services.BookingFilters
+	BookingListResponse   = services.BookingListResponse
+	UserSearchResult      = services.UserSearchResult
+
+	BookingDecisionRequest    = services.BookingDecisionRequest
+	BulkBookingActionRequest  = services.BulkBookingActionRequest
+	BulkBookingActionResponse = services.BulkBookingActionResponse
+
+	AvailabilityCheckResponse = services.AvailabilityCheckResponse
+	ConflictingBooking        = services.ConflictingBooking
+	AlternativeWindow         = services.AlternativeWindow
+)
 
 func NewAdminBookingController(service AdminBookingService) *AdminBookingController {
 	return &AdminBookingController{
@@ -121,29 +63,21 @@ func NewAdminBookingController(service AdminBookingService) *AdminBookingControl
 func (c *AdminBookingController) CreateBooking(ctx echo.Context) error {
 	// 管理者権限チェック
 	if !isAdmin(ctx) {
-		return ctx.JSON(http.StatusForbidden, map[string]string{
-			"error": "管理者権限が必要です",
-		})
+		return errAdminRequired
 	}
 
 	var req CreateBookingRequest
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request format",
-		})
+		return invalidRequest("request.invalid_format")
 	}
 
 	// バリデーション
 	if req.StartTime.IsZero() || req.EndTime.IsZero() {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "開始時間と終了時間は必須です",
-		})
+		return invalidRequest("request.time_required")
 	}
 
 	if req.EndTime.Before(req.StartTime) {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "終了時間は開始時間より後である必要があります",
-		})
+		return services.NewValidationError("INVALID_DATE_RANGE", "request.end_before_start")
 	}
 
 	// 管理者IDの取得
@@ -152,9 +86,7 @@ func (c *AdminBookingController) CreateBooking(ctx echo.Context) error {
 	// 予約作成
 	booking, err := c.adminBookingService.CreateBooking(req, adminID)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "予約の作成に失敗しました: " + err.Error(),
-		})
+		return err
 	}
 
 	return ctx.JSON(http.StatusCreated, map[string]interface{}{
@@ -168,31 +100,23 @@ func (c *AdminBookingController) CreateBooking(ctx echo.Context) error {
 func (c *AdminBookingController) UpdateBooking(ctx echo.Context) error {
 	// 管理者権限チェック
 	if !isAdmin(ctx) {
-		return ctx.JSON(http.StatusForbidden, map[string]string{
-			"error": "管理者権限が必要です",
-		})
+		return errAdminRequired
 	}
 
 	bookingID := ctx.Param("id")
 	if bookingID == "" {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "予約IDが必要です",
-		})
+		return invalidRequest("request.booking_id_required")
 	}
 
 	var req UpdateBookingRequest
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request format",
-		})
+		return invalidRequest("request.invalid_format")
 	}
 
 	// 時間のバリデーション
 	if req.StartTime != nil && req.EndTime != nil {
 		if req.EndTime.Before(*req.StartTime) {
-			return ctx.JSON(http.StatusBadRequest, map[string]string{
-				"error": "終了時間は開始時間より後である必要があります",
-			})
+			return services.NewValidationError("INVALID_DATE_RANGE", "request.end_before_start")
 		}
 	}
 
@@ -202,9 +126,7 @@ func (c *AdminBookingController) UpdateBooking(ctx echo.Context) error {
 	// 予約更新
 	booking, err := c.adminBookingService.UpdateBooking(bookingID, req, adminID)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "予約の更新に失敗しました: " + err.Error(),
-		})
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
@@ -218,16 +140,12 @@ func (c *AdminBookingController) UpdateBooking(ctx echo.Context) error {
 func (c *AdminBookingController) GetBookings(ctx echo.Context) error {
 	// 管理者権限チェック
 	if !isAdmin(ctx) {
-		return ctx.JSON(http.StatusForbidden, map[string]string{
-			"error": "管理者権限が必要です",
-		})
+		return errAdminRequired
 	}
 
 	var filters BookingFilters
 	if err := ctx.Bind(&filters); err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid filter parameters",
-		})
+		return invalidRequest("request.invalid_filters")
 	}
 
 	// ページネーション
@@ -249,9 +167,7 @@ func (c *AdminBookingController) GetBookings(ctx echo.Context) error {
 	// 予約一覧取得
 	result, err := c.adminBookingService.GetBookings(filters, page, limit)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "予約一覧の取得に失敗しました: " + err.Error(),
-		})
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
@@ -264,23 +180,17 @@ func (c *AdminBookingController) GetBookings(ctx echo.Context) error {
 func (c *AdminBookingController) GetBookingByID(ctx echo.Context) error {
 	// 管理者権限チェック
 	if !isAdmin(ctx) {
-		return ctx.JSON(http.StatusForbidden, map[string]string{
-			"error": "管理者権限が必要です",
-		})
+		return errAdminRequired
 	}
 
 	bookingID := ctx.Param("id")
 	if bookingID == "" {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "予約IDが必要です",
-		})
+		return invalidRequest("request.booking_id_required")
 	}
 
 	booking, err := c.adminBookingService.GetBookingByID(bookingID)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "予約の取得に失敗しました: " + err.Error(),
-		})
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
@@ -293,25 +203,18 @@ func (c *AdminBookingController) GetBookingByID(ctx echo.Context) error {
 func (c *AdminBookingController) DeleteBooking(ctx echo.Context) error {
 	// 管理者権限チェック
 	if !isAdmin(ctx) {
-		return ctx.JSON(http.StatusForbidden, map[string]string{
-			"error": "管理者権限が必要です",
-		})
+		return errAdminRequired
 	}
 
 	bookingID := ctx.Param("id")
 	if bookingID == "" {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "予約IDが必要です",
-		})
+		return invalidRequest("request.booking_id_required")
 	}
 
 	adminID := getUserID(ctx)
 
-	err := c.adminBookingService.DeleteBooking(bookingID, adminID)
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "予約の削除に失敗しました: " + err.Error(),
-		})
+	if err := c.adminBookingService.DeleteBooking(bookingID, adminID); err != nil {
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
@@ -320,20 +223,131 @@ func (c *AdminBookingController) DeleteBooking(ctx echo.Context) error {
 	})
 }
 
+// ApproveBooking 予約の承認
+func (c *AdminBookingController) ApproveBooking(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	bookingID := ctx.Param("id")
+	if bookingID == "" {
+		return invalidRequest("request.booking_id_required")
+	}
+
+	var req BookingDecisionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	booking, err := c.adminBookingService.ApproveBooking(bookingID, req, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"booking": booking,
+		"message": "予約を承認しました",
+	})
+}
+
+// RejectBooking 予約の拒否
+func (c *AdminBookingController) RejectBooking(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	bookingID := ctx.Param("id")
+	if bookingID == "" {
+		return invalidRequest("request.booking_id_required")
+	}
+
+	var req BookingDecisionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	booking, err := c.adminBookingService.RejectBooking(bookingID, req, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"booking": booking,
+		"message": "予約を拒否しました",
+	})
+}
+
+// BulkBookingAction 予約の一括承認・拒否・キャンセル
+func (c *AdminBookingController) BulkBookingAction(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	var req BulkBookingActionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	response, err := c.adminBookingService.BulkBookingAction(req, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+
+	// 予約ごとの失敗理由をレスポンスの言語で設定する
+	lang := i18n.Negotiate(ctx.Request().Header.Get("Accept-Language"))
+	for i := range response.Results {
+		result := &response.Results[i]
+		if result.Err == nil {
+			continue
+		}
+		status, body := errorResponse(result.Err, lang)
+		if status >= http.StatusInternalServerError {
+			ctx.Logger().Errorf("一括処理に失敗しました（予約ID: %s）: %v", result.BookingID, result.Err)
+		}
+		result.Code, result.Message = body.Code, body.Message
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// QuoteCancellation キャンセル料の見積もり
+func (c *AdminBookingController) QuoteCancellation(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	bookingID := ctx.Param("id")
+	if bookingID == "" {
+		return invalidRequest("request.booking_id_required")
+	}
+
+	quote, err := c.adminBookingService.QuoteCancellation(bookingID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"quote":   quote,
+	})
+}
+
 // SearchUsers ユーザー検索
 func (c *AdminBookingController) SearchUsers(ctx echo.Context) error {
 	// 管理者権限チェック
 	if !isAdmin(ctx) {
-		return ctx.JSON(http.StatusForbidden, map[string]string{
-			"error": "管理者権限が必要です",
-		})
+		return errAdminRequired
 	}
 
 	query := ctx.QueryParam("q")
 	if query == "" {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "検索クエリが必要です",
-		})
+		return invalidRequest("request.search_query_required")
 	}
 
 	limit := 10
@@ -345,9 +359,7 @@ func (c *AdminBookingController) SearchUsers(ctx echo.Context) error {
 
 	users, err := c.adminBookingService.SearchUsers(query, limit)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "ユーザー検索に失敗しました: " + err.Error(),
-		})
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
@@ -360,9 +372,7 @@ func (c *AdminBookingController) SearchUsers(ctx echo.Context) error {
 func (c *AdminBookingController) CheckAvailability(ctx echo.Context) error {
 	// 管理者権限チェック
 	if !isAdmin(ctx) {
-		return ctx.JSON(http.StatusForbidden, map[string]string{
-			"error": "管理者権限が必要です",
-		})
+		return errAdminRequired
 	}
 
 	startTimeStr := ctx.QueryParam("startTime")
@@ -370,38 +380,44 @@ func (c *AdminBookingController) CheckAvailability(ctx echo.Context) error {
 	excludeBookingID := ctx.QueryParam("excludeBookingId")
 
 	if startTimeStr == "" || endTimeStr == "" {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "開始時間と終了時間が必要です",
-		})
+		return invalidRequest("request.time_required")
 	}
 
 	startTime, err := time.Parse(time.RFC3339, startTimeStr)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "開始時間の形式が正しくありません",
-		})
+		return invalidRequest("request.invalid_start_time")
 	}
 
 	endTime, err := time.Parse(time.RFC3339, endTimeStr)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "終了時間の形式が正しくありません",
-		})
+		return invalidRequest("request.invalid_end_time")
 	}
 
-	available, err := c.adminBookingService.CheckAvailability(startTime, endTime, excludeBookingID)
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "空き状況の確認に失敗しました: " + err.Error(),
-		})
+	if !endTime.After(startTime) {
+		return services.NewValidationError("INVALID_DATE_RANGE", "request.end_before_start")
+	}
+
+	if excludeBookingID != "" {
+		if _, err := uuid.Parse(excludeBookingID); err != nil {
+			return invalidRequest("request.invalid_exclude_booking_id")
+		}
 	}
 
-	response := AvailabilityCheckResponse{
-		Available: available,
+	response, err := c.adminBookingService.CheckAvailability(startTime, endTime, excludeBookingID)
+	if err != nil {
+		return err
 	}
 
-	if !available {
-		response.Message = "選択された時間帯には既に予約があります"
+	// 空いていない理由と空き枠の提案有無をレスポンスの言語で伝える
+	if !response.Available {
+		key := "availability.booked"
+		if len(response.Conflicts) == 0 && response.Held {
+			key = "availability.held"
+		}
+		if len(response.Alternatives) > 0 {
+			key += "_with_alternatives"
+		}
+		response.Message = i18n.Message(i18n.Negotiate(ctx.Request().Header.Get("Accept-Language")), key)
 	}
 
 	return ctx.JSON(http.StatusOK, response)
@@ -409,27 +425,13 @@ func (c *AdminBookingController) CheckAvailability(ctx echo.Context) error {
 
 // ヘルパー関数
 func isAdmin(ctx echo.Context) bool {
-	if user := ctx.Get("user"); user != nil {
-		if userMap, ok := user.(map[string]interface{}); ok {
-			if isAdmin, exists := userMap["isAdmin"]; exists {
-				if admin, ok := isAdmin.(bool); ok {
-					return admin
-				}
-			}
-		}
-	}
-	return false
+	principal := auth.PrincipalFromContext(ctx)
+	return principal != nil && principal.IsAdmin
 }
 
 func getUserID(ctx echo.Context) string {
-	if user := ctx.Get("user"); user != nil {
-		if userMap, ok := user.(map[string]interface{}); ok {
-			if id, exists := userMap["id"]; exists {
-				if userID, ok := id.(string); ok {
-					return userID
-				}
-			}
-		}
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		return principal.ID
 	}
 	return ""
 }
@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/services"
+)
+
+const (
+	// minPasswordLength はパスワードの最小文字数
+	minPasswordLength = 8
+	// maxPasswordLength はパスワードの最大バイト数（bcryptでハッシュ化できる上限）
+	maxPasswordLength = 72
+)
+
+type AuthController struct {
+	authService AuthService
+}
+
+type AuthService interface {
+	Register(req RegisterRequest, client ClientInfo) (*AuthResponse, error)
+	Login(req LoginRequest, client ClientInfo) (*AuthResponse, error)
+	Refresh(refreshToken string, client ClientInfo) (*TokenResponse, error)
+	Logout(refreshToken string) error
+}
+
+// リクエスト・レスポンス型はサービス層の定義を共有する
+type (
+	RegisterRequest     = services.RegisterRequest
+	LoginRequest        = services.LoginRequest
+	RefreshTokenRequest = services.RefreshTokenRequest
+	ClientInfo          = services.ClientInfo
+	TokenResponse       = services.TokenResponse
+	AuthResponse        = services.AuthResponse
+)
+
+func NewAuthController(service AuthService) *AuthController {
+	return &AuthController{
+		authService: service,
+	}
+}
+
+// Register ユーザー登録
+func (c *AuthController) Register(ctx echo.Context) error {
+	var req RegisterRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	if req.Email == "" || req.Password == "" || strings.TrimSpace(req.FullName) == "" {
+		return invalidRequest("auth.register_fields_required")
+	}
+	if _, err := mail.ParseAddress(req.Email); err != nil {
+		return invalidRequest("auth.invalid_email")
+	}
+	if len(req.Password) < minPasswordLength {
+		return invalidRequest("auth.password_too_short", minPasswordLength)
+	}
+	if len(req.Password) > maxPasswordLength {
+		return invalidRequest("auth.password_too_long", maxPasswordLength)
+	}
+
+	result, err := c.authService.Register(req, clientInfo(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, result)
+}
+
+// Login ログイン
+func (c *AuthController) Login(ctx echo.Context) error {
+	var req LoginRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	if req.Email == "" || req.Password == "" {
+		return invalidRequest("auth.login_fields_required")
+	}
+
+	result, err := c.authService.Login(req, clientInfo(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// Refresh トークン更新
+func (c *AuthController) Refresh(ctx echo.Context) error {
+	var req RefreshTokenRequest
+	if err := ctx.Bind(&req); err != nil || req.RefreshToken == "" {
+		return invalidRequest("auth.refresh_token_required")
+	}
+
+	tokens, err := c.authService.Refresh(req.RefreshToken, clientInfo(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, tokens)
+}
+
+// Logout ログアウト
+func (c *AuthController) Logout(ctx echo.Context) error {
+	var req RefreshTokenRequest
+	if err := ctx.Bind(&req); err != nil || req.RefreshToken == "" {
+		return invalidRequest("auth.refresh_token_required")
+	}
+
+	if err := c.authService.Logout(req.RefreshToken); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// clientInfo リクエスト元の情報を取得
+func clientInfo(ctx echo.Context) ClientInfo {
+	return ClientInfo{
+		IPAddress: ctx.RealIP(),
+		UserAgent: ctx.Request().UserAgent(),
+	}
+}
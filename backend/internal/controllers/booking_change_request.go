@@ -0,0 +1,173 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/services"
+)
+
+type BookingChangeRequestController struct {
+	changeRequestService BookingChangeRequestService
+}
+
+type BookingChangeRequestService interface {
+	RequestChange(bookingID, userID string, req CreateChangeRequestRequest) (*BookingChangeRequestResponse, error)
+	GetBookingChangeRequests(bookingID, userID string) ([]BookingChangeRequestResponse, error)
+	ListChangeRequests(status string, limit int) ([]BookingChangeRequestResponse, error)
+	AcceptChangeRequest(requestID, adminID string, req ChangeRequestDecisionRequest) (*BookingChangeRequestResponse, error)
+	DeclineChangeRequest(requestID, adminID string, req ChangeRequestDecisionRequest) (*BookingChangeRequestResponse, error)
+}
+
+// リクエスト・レスポンス型はサービス層の定義を共有する
+type (
+	CreateChangeRequestRequest   = services.CreateChangeRequestRequest
+	ChangeRequestDecisionRequest = services.ChangeRequestDecisionRequest
+	BookingChangeRequestResponse = services.BookingChangeRequestResponse
+)
+
+func NewBookingChangeRequestController(service BookingChangeRequestService) *BookingChangeRequestController {
+	return &BookingChangeRequestController{
+		changeRequestService: service,
+	}
+}
+
+// RequestChange 自分の予約の変更申請
+func (c *BookingChangeRequestController) RequestChange(ctx echo.Context) error {
+	bookingID := ctx.Param("id")
+	if bookingID == "" {
+		return invalidRequest("request.booking_id_required")
+	}
+
+	var req CreateChangeRequestRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	// 時間帯を変更する場合は開始・終了の両方が必要
+	if (req.StartTime == nil) != (req.EndTime == nil) {
+		return invalidRequest("request.time_required")
+	}
+	if req.StartTime != nil {
+		if !req.EndTime.After(*req.StartTime) {
+			return services.NewValidationError("INVALID_DATE_RANGE", "request.end_before_start")
+		}
+		if !req.StartTime.After(time.Now()) {
+			return services.NewValidationError("INVALID_DATE_RANGE", "request.past_start")
+		}
+	}
+
+	changeRequest, err := c.changeRequestService.RequestChange(bookingID, getUserID(ctx), req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, map[string]interface{}{
+		"success":       true,
+		"changeRequest": changeRequest,
+		"message":       "予約の変更を申請しました",
+	})
+}
+
+// GetBookingChangeRequests 自分の予約の変更申請一覧
+func (c *BookingChangeRequestController) GetBookingChangeRequests(ctx echo.Context) error {
+	bookingID := ctx.Param("id")
+	if bookingID == "" {
+		return invalidRequest("request.booking_id_required")
+	}
+
+	changeRequests, err := c.changeRequestService.GetBookingChangeRequests(bookingID, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"changeRequests": changeRequests,
+	})
+}
+
+// ListChangeRequests 変更申請一覧（管理者）
+func (c *BookingChangeRequestController) ListChangeRequests(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	limit := 50
+	if limitStr := ctx.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	changeRequests, err := c.changeRequestService.ListChangeRequests(ctx.QueryParam("status"), limit)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":        true,
+		"changeRequests": changeRequests,
+	})
+}
+
+// AcceptChangeRequest 変更申請の承認
+func (c *BookingChangeRequestController) AcceptChangeRequest(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	requestID := ctx.Param("id")
+	if requestID == "" {
+		return invalidRequest("request.change_request_id_required")
+	}
+
+	var req ChangeRequestDecisionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	changeRequest, err := c.changeRequestService.AcceptChangeRequest(requestID, getUserID(ctx), req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":       true,
+		"changeRequest": changeRequest,
+		"message":       "予約の変更を承認しました",
+	})
+}
+
+// DeclineChangeRequest 変更申請の却下
+func (c *BookingChangeRequestController) DeclineChangeRequest(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	requestID := ctx.Param("id")
+	if requestID == "" {
+		return invalidRequest("request.change_request_id_required")
+	}
+
+	var req ChangeRequestDecisionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	changeRequest, err := c.changeRequestService.DeclineChangeRequest(requestID, getUserID(ctx), req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":       true,
+		"changeRequest": changeRequest,
+		"message":       "予約の変更を却下しました",
+	})
+}
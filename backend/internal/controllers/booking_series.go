@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/i18n"
+	"github.com/zebraApp/internal/services"
+)
+
+// リクエスト・レスポンス型はサービス層の定義を共有する
+type (
+	CreateBookingSeriesRequest = services.CreateBookingSeriesRequest
+	UpdateBookingSeriesRequest = services.UpdateBookingSeriesRequest
+	CancelBookingSeriesRequest = services.CancelBookingSeriesRequest
+	BookingSeriesResponse      = services.BookingSeriesResponse
+	BookingSeriesResult        = services.BookingSeriesResult
+)
+
+// CreateBookingSeries 定期予約の作成
+// 重複などで作成できなかった回は結果に含めて返す
+func (c *AdminBookingController) CreateBookingSeries(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	var req CreateBookingSeriesRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	// バリデーション
+	if req.StartTime.IsZero() || req.EndTime.IsZero() {
+		return invalidRequest("request.time_required")
+	}
+	if !req.EndTime.After(req.StartTime) {
+		return services.NewValidationError("INVALID_DATE_RANGE", "request.end_before_start")
+	}
+
+	result, err := c.adminBookingService.CreateBookingSeries(req, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+	localizeSeriesResult(ctx, result)
+
+	status := http.StatusCreated
+	if !result.Committed {
+		status = http.StatusOK
+	}
+	return ctx.JSON(status, result)
+}
+
+// GetBookingSeries 定期予約の詳細
+func (c *AdminBookingController) GetBookingSeries(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	seriesID := ctx.Param("id")
+	if seriesID == "" {
+		return invalidRequest("request.series_id_required")
+	}
+
+	series, err := c.adminBookingService.GetBookingSeries(seriesID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"series":  series,
+	})
+}
+
+// UpdateBookingSeries 定期予約の編集（この回のみ・この回以降・すべての回）
+func (c *AdminBookingController) UpdateBookingSeries(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	seriesID := ctx.Param("id")
+	if seriesID == "" {
+		return invalidRequest("request.series_id_required")
+	}
+
+	var req UpdateBookingSeriesRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	result, err := c.adminBookingService.UpdateBookingSeries(seriesID, req, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+	localizeSeriesResult(ctx, result)
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CancelBookingSeries 定期予約のキャンセル（この回のみ・この回以降・すべての回）
+func (c *AdminBookingController) CancelBookingSeries(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	seriesID := ctx.Param("id")
+	if seriesID == "" {
+		return invalidRequest("request.series_id_required")
+	}
+
+	var req CancelBookingSeriesRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	result, err := c.adminBookingService.CancelBookingSeries(seriesID, req, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+	localizeSeriesResult(ctx, result)
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// localizeSeriesResult 回ごとの失敗理由をレスポンスの言語で設定する
+func localizeSeriesResult(ctx echo.Context, result *BookingSeriesResult) {
+	lang := i18n.Negotiate(ctx.Request().Header.Get("Accept-Language"))
+	for i := range result.Occurrences {
+		occurrence := &result.Occurrences[i]
+		if occurrence.Err == nil {
+			continue
+		}
+		status, body := errorResponse(occurrence.Err, lang)
+		if status >= http.StatusInternalServerError {
+			ctx.Logger().Errorf("定期予約の処理に失敗しました（回: %s）: %v", occurrence.OccurrenceDate, occurrence.Err)
+		}
+		occurrence.Code, occurrence.Message = body.Code, body.Message
+	}
+}
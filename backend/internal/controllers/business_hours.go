@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/services"
+)
+
+type BusinessHoursController struct {
+	businessHoursService BusinessHoursService
+}
+
+type BusinessHoursService interface {
+	GetBusinessHours() ([]BusinessHoursResponse, error)
+	CreateBusinessHours(req BusinessHoursRequest) (*BusinessHoursResponse, error)
+	UpdateBusinessHours(businessHoursID string, req BusinessHoursRequest) (*BusinessHoursResponse, error)
+	DeleteBusinessHours(businessHoursID string) error
+}
+
+// リクエスト・レスポンス型はサービス層の定義を共有する
+type (
+	BusinessHoursRequest  = services.BusinessHoursRequest
+	BusinessHoursResponse = services.BusinessHoursResponse
+)
+
+func NewBusinessHoursController(service BusinessHoursService) *BusinessHoursController {
+	return &BusinessHoursController{
+		businessHoursService: service,
+	}
+}
+
+// GetBusinessHours 営業時間設定一覧取得
+func (c *BusinessHoursController) GetBusinessHours(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	hours, err := c.businessHoursService.GetBusinessHours()
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":       true,
+		"businessHours": hours,
+	})
+}
+
+// CreateBusinessHours 営業時間設定作成
+func (c *BusinessHoursController) CreateBusinessHours(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	var req BusinessHoursRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	hours, err := c.businessHoursService.CreateBusinessHours(req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, map[string]interface{}{
+		"success":       true,
+		"businessHours": hours,
+	})
+}
+
+// UpdateBusinessHours 営業時間設定更新
+func (c *BusinessHoursController) UpdateBusinessHours(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	var req BusinessHoursRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	hours, err := c.businessHoursService.UpdateBusinessHours(ctx.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":       true,
+		"businessHours": hours,
+	})
+}
+
+// DeleteBusinessHours 営業時間設定削除
+func (c *BusinessHoursController) DeleteBusinessHours(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	if err := c.businessHoursService.DeleteBusinessHours(ctx.Param("id")); err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"message": "営業時間設定を削除しました",
+	})
+}
@@ -5,100 +5,93 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/auth"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/services"
 )
 
 type CalendarController struct {
 	calendarService CalendarService
+	// location は日付のみのクエリ（YYYY-MM-DD）を解釈するスタジオのタイムゾーン
+	location *time.Location
 }
 
 type CalendarService interface {
-	GetEvents(startDate, endDate time.Time, userID string) ([]EventResponse, error)
+	GetEvents(startDate, endDate time.Time, viewer *auth.Principal) ([]EventResponse, error)
 	GetAvailability(startDate, endDate time.Time) ([]AvailabilitySlot, error)
+	QuotePrice(startTime, endTime time.Time) (models.PriceBreakdown, error)
+	SearchWindows(startDate, endDate time.Time, req WindowSearchRequest) (*WindowSearchResponse, error)
 }
 
-type EventResponse struct {
-	ID              string                 `json:"id"`
-	Title           string                 `json:"title"`
-	Start           string                 `json:"start"`
-	End             string                 `json:"end"`
-	BackgroundColor string                 `json:"backgroundColor"`
-	BorderColor     string                 `json:"borderColor"`
-	TextColor       string                 `json:"textColor"`
-	ExtendedProps   map[string]interface{} `json:"extendedProps"`
-}
+// イベント・空き枠の型はサービス層の定義を共有する
+type (
+	EventResponse    = services.EventResponse
+	AvailabilitySlot = services.AvailabilitySlot
 
-type AvailabilitySlot struct {
-	Start     string `json:"start"`
-	End       string `json:"end"`
-	Available bool   `json:"available"`
-	Type      string `json:"type"` // "business_hours", "break", "blocked"
-}
+	WindowSearchRequest  = services.WindowSearchRequest
+	WindowSearchResponse = services.WindowSearchResponse
+	FreeWindow           = services.FreeWindow
+)
 
 type CalendarEventsResponse struct {
 	Events       []EventResponse    `json:"events"`
 	Availability []AvailabilitySlot `json:"availability"`
 }
 
-func NewCalendarController(calendarService CalendarService) *CalendarController {
+func NewCalendarController(calendarService CalendarService, loc *time.Location) *CalendarController {
+	if loc == nil {
+		loc = time.Local
+	}
 	return &CalendarController{
 		calendarService: calendarService,
+		location:        loc,
 	}
 }
 
-// GetEvents カレンダーイベントと空き状況を取得
-func (c *CalendarController) GetEvents(ctx echo.Context) error {
-	// クエリパラメータの取得
+// dateRange クエリのstart・end（YYYY-MM-DD）をスタジオのタイムゾーンの日付として解釈する
+// 終了日はその日の終わり（翌日0時）を返す。夏時間のある地域でも日付の境界がずれないようAddDateで進める
+func (c *CalendarController) dateRange(ctx echo.Context) (time.Time, time.Time, error) {
 	startStr := ctx.QueryParam("start")
 	endStr := ctx.QueryParam("end")
 
 	if startStr == "" || endStr == "" {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "start and end parameters are required",
-		})
+		return time.Time{}, time.Time{}, invalidRequest("request.date_range_required")
 	}
 
-	// 日付のパース
-	startDate, err := time.Parse("2006-01-02", startStr)
+	startDate, err := time.ParseInLocation("2006-01-02", startStr, c.location)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid start date format. Use YYYY-MM-DD",
-		})
+		return time.Time{}, time.Time{}, invalidRequest("request.invalid_date")
 	}
 
-	endDate, err := time.Parse("2006-01-02", endStr)
+	endDate, err := time.ParseInLocation("2006-01-02", endStr, c.location)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid end date format. Use YYYY-MM-DD",
-		})
+		return time.Time{}, time.Time{}, invalidRequest("request.invalid_date")
+	}
+	if endDate.Before(startDate) {
+		return time.Time{}, time.Time{}, services.NewValidationError("INVALID_DATE_RANGE", "request.end_before_start")
 	}
 
-	// 終了日を翌日の開始時刻まで延長（FullCalendarの仕様に合わせる）
-	endDate = endDate.Add(24 * time.Hour)
+	return startDate, endDate.AddDate(0, 0, 1), nil
+}
 
-	// ユーザーIDの取得（認証ミドルウェアから）
-	userID := ""
-	if user := ctx.Get("user"); user != nil {
-		if userMap, ok := user.(map[string]interface{}); ok {
-			if id, exists := userMap["id"]; exists {
-				userID = id.(string)
-			}
-		}
+// GetEvents カレンダーイベントと空き状況を取得
+func (c *CalendarController) GetEvents(ctx echo.Context) error {
+	// クエリパラメータの取得（終了日は翌日の開始時刻まで延長する。FullCalendarの仕様に合わせる）
+	startDate, endDate, err := c.dateRange(ctx)
+	if err != nil {
+		return err
 	}
 
-	// イベントデータの取得
-	events, err := c.calendarService.GetEvents(startDate, endDate, userID)
+	// イベントデータの取得（未認証の場合は予約済みの時間帯のみ）
+	events, err := c.calendarService.GetEvents(startDate, endDate, auth.PrincipalFromContext(ctx))
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "Failed to fetch events",
-		})
+		return err
 	}
 
 	// 空き状況の取得
 	availability, err := c.calendarService.GetAvailability(startDate, endDate)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "Failed to fetch availability",
-		})
+		return err
 	}
 
 	response := CalendarEventsResponse{
@@ -111,39 +104,71 @@ func (c *CalendarController) GetEvents(ctx echo.Context) error {
 
 // GetAvailability 空き状況のみを取得
 func (c *CalendarController) GetAvailability(ctx echo.Context) error {
-	startStr := ctx.QueryParam("start")
-	endStr := ctx.QueryParam("end")
+	startDate, endDate, err := c.dateRange(ctx)
+	if err != nil {
+		return err
+	}
+
+	availability, err := c.calendarService.GetAvailability(startDate, endDate)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"availability": availability,
+	})
+}
+
+// SearchWindows 指定の長さの予約を入れられる開始時刻を検索
+// 例: /api/calendar/search?start=2025-01-06&end=2025-01-12&duration=180&granularity=30&from=10:00&until=20:00
+func (c *CalendarController) SearchWindows(ctx echo.Context) error {
+	startDate, endDate, err := c.dateRange(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req WindowSearchRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	result, err := c.calendarService.SearchWindows(startDate, endDate, req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// GetPriceQuote 指定時間の利用料金を料金帯ごとの内訳付きで見積もる
+func (c *CalendarController) GetPriceQuote(ctx echo.Context) error {
+	startStr := ctx.QueryParam("startTime")
+	endStr := ctx.QueryParam("endTime")
 
 	if startStr == "" || endStr == "" {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "start and end parameters are required",
-		})
+		return invalidRequest("request.time_required")
 	}
 
-	startDate, err := time.Parse("2006-01-02", startStr)
+	startTime, err := time.Parse(time.RFC3339, startStr)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid start date format. Use YYYY-MM-DD",
-		})
+		return invalidRequest("request.invalid_start_time")
 	}
 
-	endDate, err := time.Parse("2006-01-02", endStr)
+	endTime, err := time.Parse(time.RFC3339, endStr)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid end date format. Use YYYY-MM-DD",
-		})
+		return invalidRequest("request.invalid_end_time")
 	}
 
-	endDate = endDate.Add(24 * time.Hour)
+	if !endTime.After(startTime) {
+		return services.NewValidationError("INVALID_DATE_RANGE", "request.end_before_start")
+	}
 
-	availability, err := c.calendarService.GetAvailability(startDate, endDate)
+	breakdown, err := c.calendarService.QuotePrice(startTime, endTime)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "Failed to fetch availability",
-		})
+		return err
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
-		"availability": availability,
+		"price": breakdown,
 	})
 }
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/i18n"
+	"github.com/zebraApp/internal/services"
+	"github.com/zebraApp/internal/validation"
+)
+
+// ErrorResponse はすべてのAPIで共通のエラーレスポンス（フロントエンドはmessageを参照する）
+type ErrorResponse struct {
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// errAdminRequired は管理者以外が管理者用APIを呼び出したことを表す
+var errAdminRequired = services.NewForbiddenError("FORBIDDEN", "auth.admin_required")
+
+// invalidRequest リクエスト内容の誤り（INVALID_PARAMETERS）を返す
+func invalidRequest(key string, args ...interface{}) error {
+	return services.NewValidationError("INVALID_PARAMETERS", key, args...)
+}
+
+// kindStatus はエラーの種類ごとのHTTPステータス
+var kindStatus = map[services.ErrorKind]int{
+	services.KindValidation:   http.StatusBadRequest,
+	services.KindUnauthorized: http.StatusUnauthorized,
+	services.KindForbidden:    http.StatusForbidden,
+	services.KindNotFound:     http.StatusNotFound,
+	services.KindConflict:     http.StatusConflict,
+}
+
+// statusCode はecho.HTTPErrorのステータスごとのエラーコード
+var statusCode = map[int]string{
+	http.StatusBadRequest:   "INVALID_PARAMETERS",
+	http.StatusUnauthorized: "UNAUTHORIZED",
+	http.StatusForbidden:    "FORBIDDEN",
+	http.StatusNotFound:     "NOT_FOUND",
+	http.StatusConflict:     "CONFLICT",
+}
+
+// HTTPErrorHandler はハンドラーやミドルウェアが返したエラーを共通形式のレスポンスに変換する
+// 型付きのエラー以外は内容をクライアントに返さず、ログにのみ出力する
+func HTTPErrorHandler(err error, ctx echo.Context) {
+	if ctx.Response().Committed {
+		return
+	}
+
+	lang := i18n.Negotiate(ctx.Request().Header.Get("Accept-Language"))
+	status, body := errorResponse(err, lang)
+	if status >= http.StatusInternalServerError {
+		ctx.Logger().Errorf("%s %s: %v", ctx.Request().Method, ctx.Path(), err)
+	}
+
+	var writeErr error
+	if ctx.Request().Method == http.MethodHead {
+		writeErr = ctx.NoContent(status)
+	} else {
+		writeErr = ctx.JSON(status, body)
+	}
+	if writeErr != nil {
+		ctx.Logger().Error(writeErr)
+	}
+}
+
+// errorResponse エラーをHTTPステータスとレスポンスに変換する
+func errorResponse(err error, lang i18n.Lang) (int, ErrorResponse) {
+	if typed, ok := services.AsError(err); ok {
+		status, ok := kindStatus[typed.Kind]
+		if !ok {
+			status = http.StatusInternalServerError
+		}
+		return status, ErrorResponse{
+			Code:    typed.Code,
+			Message: typed.Message(lang),
+			Details: localizeDetails(typed.Details, lang),
+		}
+	}
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) && httpErr.Code < http.StatusInternalServerError {
+		// ミドルウェアはメッセージにエラーコードを設定する（例: TOKEN_EXPIRED）
+		code, _ := httpErr.Message.(string)
+		if !i18n.Has("code." + code) {
+			code = statusCode[httpErr.Code]
+		}
+		if code == "" {
+			code = "INVALID_PARAMETERS"
+		}
+		return httpErr.Code, ErrorResponse{
+			Code:    code,
+			Message: i18n.Message(lang, "code."+code),
+		}
+	}
+
+	return http.StatusInternalServerError, ErrorResponse{
+		Code:    "SERVER_ERROR",
+		Message: i18n.Message(lang, "code.SERVER_ERROR"),
+	}
+}
+
+// localizeDetails details内の業務ルール違反のメッセージをレスポンスの言語にする
+func localizeDetails(details map[string]interface{}, lang i18n.Lang) map[string]interface{} {
+	violations, ok := details["violations"].(validation.Violations)
+	if !ok {
+		return details
+	}
+	localized := make(map[string]interface{}, len(details))
+	for key, value := range details {
+		localized[key] = value
+	}
+	localized["violations"] = violations.Localize(lang)
+	return localized
+}
@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/holiday"
+	"github.com/zebraApp/internal/services"
+)
+
+type HolidayController struct {
+	holidayService HolidayService
+}
+
+type HolidayService interface {
+	GetHolidays(year int) ([]HolidayResponse, error)
+	ImportHolidays(holidays []holiday.Holiday, replace bool) (int, error)
+	DeleteHoliday(holidayID string) error
+}
+
+// リクエスト・レスポンス型はサービス層の定義を共有する
+type (
+	HolidayImportRequest = services.HolidayImportRequest
+	HolidayResponse      = services.HolidayResponse
+)
+
+func NewHolidayController(service HolidayService) *HolidayController {
+	return &HolidayController{
+		holidayService: service,
+	}
+}
+
+// GetHolidays 祝日・休日一覧取得（国民の祝日と独自の休日）
+func (c *HolidayController) GetHolidays(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	year := time.Now().Year()
+	if yearStr := ctx.QueryParam("year"); yearStr != "" {
+		y, err := strconv.Atoi(yearStr)
+		if err != nil || y < 1 {
+			return invalidRequest("request.invalid_year")
+		}
+		year = y
+	}
+
+	holidays, err := c.holidayService.GetHolidays(year)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":  true,
+		"year":     year,
+		"holidays": holidays,
+	})
+}
+
+// ImportHolidays 独自の休日一覧の取り込み
+// JSON（{"holidays":[{"date","name"}],"replace"}）またはCSV（"YYYY-MM-DD,名前"、?replace=true）を受け付ける
+func (c *HolidayController) ImportHolidays(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	var holidays []holiday.Holiday
+	var replace bool
+	if strings.HasPrefix(ctx.Request().Header.Get(echo.HeaderContentType), "text/csv") {
+		parsed, err := holiday.ParseCSV(ctx.Request().Body)
+		if err != nil {
+			return services.ErrInvalidHoliday.WithMessage("holiday.invalid_csv")
+		}
+		holidays = parsed
+		replace = ctx.QueryParam("replace") == "true"
+	} else {
+		var req HolidayImportRequest
+		if err := ctx.Bind(&req); err != nil {
+			return invalidRequest("request.invalid_format")
+		}
+		for _, h := range req.Holidays {
+			date, err := time.Parse("2006-01-02", h.Date)
+			if err != nil {
+				return invalidRequest("request.invalid_date")
+			}
+			holidays = append(holidays, holiday.Holiday{Date: date, Name: strings.TrimSpace(h.Name)})
+		}
+		replace = req.Replace
+	}
+
+	imported, err := c.holidayService.ImportHolidays(holidays, replace)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":  true,
+		"imported": imported,
+		"message":  "休日を取り込みました",
+	})
+}
+
+// DeleteHoliday 独自の休日の削除
+func (c *HolidayController) DeleteHoliday(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	if err := c.holidayService.DeleteHoliday(ctx.Param("id")); err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"message": "休日を削除しました",
+	})
+}
@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/services"
+)
+
+type PasswordResetController struct {
+	passwordResetService PasswordResetService
+}
+
+type PasswordResetService interface {
+	RequestReset(email string) error
+	ResetPassword(token, newPassword string) error
+}
+
+// リクエスト型はサービス層の定義を共有する
+type (
+	ForgotPasswordRequest = services.ForgotPasswordRequest
+	ResetPasswordRequest  = services.ResetPasswordRequest
+)
+
+func NewPasswordResetController(service PasswordResetService) *PasswordResetController {
+	return &PasswordResetController{
+		passwordResetService: service,
+	}
+}
+
+// ForgotPassword パスワードリセットメールの送信
+func (c *PasswordResetController) ForgotPassword(ctx echo.Context) error {
+	var req ForgotPasswordRequest
+	if err := ctx.Bind(&req); err != nil || req.Email == "" {
+		return invalidRequest("password_reset.email_required")
+	}
+
+	if err := c.passwordResetService.RequestReset(req.Email); err != nil {
+		return err
+	}
+
+	// 登録有無に関わらず同じレスポンスを返す
+	return ctx.JSON(http.StatusOK, map[string]string{
+		"message": "パスワードリセット手順をメールで送信しました",
+	})
+}
+
+// ResetPassword 新しいパスワードの設定
+func (c *PasswordResetController) ResetPassword(ctx echo.Context) error {
+	var req ResetPasswordRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	if req.Token == "" || req.NewPassword == "" {
+		return invalidRequest("password_reset.fields_required")
+	}
+	if len(req.NewPassword) < minPasswordLength {
+		return invalidRequest("auth.password_too_short", minPasswordLength)
+	}
+	if len(req.NewPassword) > maxPasswordLength {
+		return invalidRequest("auth.password_too_long", maxPasswordLength)
+	}
+
+	if err := c.passwordResetService.ResetPassword(req.Token, req.NewPassword); err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]string{
+		"message": "パスワードが正常に更新されました",
+	})
+}
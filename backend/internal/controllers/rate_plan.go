@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/services"
+)
+
+type RatePlanController struct {
+	ratePlanService RatePlanService
+}
+
+type RatePlanService interface {
+	GetRatePlans() ([]RatePlanResponse, error)
+	CreateRatePlan(req RatePlanRequest) (*RatePlanResponse, error)
+	UpdateRatePlan(ratePlanID string, req RatePlanRequest) (*RatePlanResponse, error)
+	DeleteRatePlan(ratePlanID string) error
+}
+
+// リクエスト・レスポンス型はサービス層の定義を共有する
+type (
+	RatePlanRequest  = services.RatePlanRequest
+	RatePlanResponse = services.RatePlanResponse
+)
+
+func NewRatePlanController(service RatePlanService) *RatePlanController {
+	return &RatePlanController{
+		ratePlanService: service,
+	}
+}
+
+// GetRatePlans 料金プラン一覧取得
+func (c *RatePlanController) GetRatePlans(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	plans, err := c.ratePlanService.GetRatePlans()
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"ratePlans": plans,
+	})
+}
+
+// CreateRatePlan 料金プラン作成
+func (c *RatePlanController) CreateRatePlan(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	var req RatePlanRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	plan, err := c.ratePlanService.CreateRatePlan(req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, map[string]interface{}{
+		"success":  true,
+		"ratePlan": plan,
+	})
+}
+
+// UpdateRatePlan 料金プラン更新
+func (c *RatePlanController) UpdateRatePlan(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	var req RatePlanRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	plan, err := c.ratePlanService.UpdateRatePlan(ctx.Param("id"), req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":  true,
+		"ratePlan": plan,
+	})
+}
+
+// DeleteRatePlan 料金プラン削除
+func (c *RatePlanController) DeleteRatePlan(ctx echo.Context) error {
+	// 管理者権限チェック
+	if !isAdmin(ctx) {
+		return errAdminRequired
+	}
+
+	if err := c.ratePlanService.DeleteRatePlan(ctx.Param("id")); err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"message": "料金プランを削除しました",
+	})
+}
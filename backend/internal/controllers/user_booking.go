@@ -0,0 +1,221 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/services"
+)
+
+type UserBookingController struct {
+	userBookingService UserBookingService
+}
+
+type UserBookingService interface {
+	CreateBooking(req UserCreateBookingRequest, userID string) (*BookingResponse, error)
+	GetBookings(userID string, filters UserBookingFilters, limit, offset int) (*UserBookingListResponse, error)
+	GetBookingByID(bookingID, userID string) (*BookingResponse, bool, error)
+	CancelBooking(bookingID, userID, reason string) (*BookingResponse, error)
+	ConfirmBooking(bookingID, userID string) (*BookingResponse, error)
+	QuoteCancellation(bookingID, userID string) (*CancellationQuote, error)
+}
+
+// リクエスト・レスポンス型はサービス層の定義を共有する
+type (
+	UserCreateBookingRequest = services.UserCreateBookingRequest
+	UserBookingFilters       = services.UserBookingFilters
+	UserBookingListResponse  = services.UserBookingListResponse
+	CancelBookingRequest     = services.CancelBookingRequest
+	CancellationQuote        = services.CancellationQuote
+)
+
+// PublicBookingInfo 他のユーザーの予約として公開する最小限の情報
+type PublicBookingInfo struct {
+	ID          string    `json:"id"`
+	BookingType string    `json:"bookingType"`
+	StartTime   time.Time `json:"startTime"`
+	EndTime     time.Time `json:"endTime"`
+	Status      string    `json:"status"` // 常に "occupied"
+}
+
+func NewUserBookingController(service UserBookingService) *UserBookingController {
+	return &UserBookingController{
+		userBookingService: service,
+	}
+}
+
+// CreateBooking 予約申請
+func (c *UserBookingController) CreateBooking(ctx echo.Context) error {
+	var req UserCreateBookingRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	if req.StartTime.IsZero() || req.EndTime.IsZero() {
+		return invalidRequest("request.time_required")
+	}
+	if !req.EndTime.After(req.StartTime) {
+		return services.NewValidationError("INVALID_DATE_RANGE", "request.end_before_start")
+	}
+	if !req.StartTime.After(time.Now()) {
+		return services.NewValidationError("INVALID_DATE_RANGE", "request.past_start")
+	}
+	if req.BookingType != string(models.BookingTypeTemporary) && req.BookingType != string(models.BookingTypeConfirmed) {
+		return services.NewValidationError("INVALID_BOOKING_TYPE", "request.invalid_booking_type")
+	}
+
+	booking, err := c.userBookingService.CreateBooking(req, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+
+	message := "予約申請を受け付けました"
+	if booking.KeepOrder > models.KeepOrderFirst {
+		message = fmt.Sprintf("第%dキープとして予約申請を受け付けました", booking.KeepOrder)
+	}
+
+	return ctx.JSON(http.StatusCreated, map[string]interface{}{
+		"success":      true,
+		"bookingId":    booking.ID,
+		"booking":      booking,
+		"keepPosition": booking.KeepOrder,
+		"message":      message,
+	})
+}
+
+// GetBookings 自分の予約履歴取得
+func (c *UserBookingController) GetBookings(ctx echo.Context) error {
+	var filters UserBookingFilters
+	if err := ctx.Bind(&filters); err != nil {
+		return invalidRequest("request.invalid_filters")
+	}
+
+	// ページネーション
+	limit := 10
+	offset := 0
+
+	if limitStr := ctx.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	if offsetStr := ctx.QueryParam("offset"); offsetStr != "" {
+		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
+			offset = o
+		}
+	}
+
+	result, err := c.userBookingService.GetBookings(getUserID(ctx), filters, limit, offset)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":     true,
+		"bookings":    result.Bookings,
+		"totalCount":  result.TotalCount,
+		"limit":       result.Limit,
+		"offset":      result.Offset,
+		"hasNextPage": result.HasNextPage,
+	})
+}
+
+// GetBookingByID 予約詳細取得
+// 他のユーザーの予約は時間帯のみを返す
+func (c *UserBookingController) GetBookingByID(ctx echo.Context) error {
+	bookingID := ctx.Param("id")
+	if bookingID == "" {
+		return invalidRequest("request.booking_id_required")
+	}
+
+	booking, isOwn, err := c.userBookingService.GetBookingByID(bookingID, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+
+	if !isOwn && !isAdmin(ctx) {
+		return ctx.JSON(http.StatusOK, map[string]interface{}{
+			"success":      true,
+			"isOwnBooking": false,
+			"booking": PublicBookingInfo{
+				ID:          booking.ID,
+				BookingType: booking.BookingType,
+				StartTime:   booking.StartTime,
+				EndTime:     booking.EndTime,
+				Status:      "occupied",
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success":      true,
+		"isOwnBooking": isOwn,
+		"booking":      booking,
+	})
+}
+
+// CancelBooking 自分の予約のキャンセル
+func (c *UserBookingController) CancelBooking(ctx echo.Context) error {
+	bookingID := ctx.Param("id")
+	if bookingID == "" {
+		return invalidRequest("request.booking_id_required")
+	}
+
+	var req CancelBookingRequest
+	if err := ctx.Bind(&req); err != nil {
+		return invalidRequest("request.invalid_format")
+	}
+
+	booking, err := c.userBookingService.CancelBooking(bookingID, getUserID(ctx), req.Reason)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"booking": booking,
+		"message": "予約をキャンセルしました",
+	})
+}
+
+// QuoteCancellation キャンセル料の見積もり
+func (c *UserBookingController) QuoteCancellation(ctx echo.Context) error {
+	bookingID := ctx.Param("id")
+	if bookingID == "" {
+		return invalidRequest("request.booking_id_required")
+	}
+
+	quote, err := c.userBookingService.QuoteCancellation(bookingID, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"quote":   quote,
+	})
+}
+
+// ConfirmBooking 仮予約から本予約への変更
+func (c *UserBookingController) ConfirmBooking(ctx echo.Context) error {
+	bookingID := ctx.Param("id")
+	if bookingID == "" {
+		return invalidRequest("request.booking_id_required")
+	}
+
+	booking, err := c.userBookingService.ConfirmBooking(bookingID, getUserID(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"success": true,
+		"booking": booking,
+		"message": "本予約に変更しました",
+	})
+}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // database/sql用のPostgreSQLドライバ
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// DB はdatabase/sqlとGORMの両方の接続を保持する構造体
+// 両者は同じコネクションプールを共有する
+type DB struct {
+	SQL  *sql.DB
+	Gorm *gorm.DB
+}
+
+// Open はデータベースURLからPostgreSQLへの接続を確立する
+func Open(databaseURL string) (*DB, error) {
+	sqlDB, err := sql.Open("pgx", databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("データベース接続の作成に失敗しました: %w", err)
+	}
+
+	// コネクションプールの設定
+	sqlDB.SetMaxOpenConns(25)
+	sqlDB.SetMaxIdleConns(5)
+	sqlDB.SetConnMaxLifetime(30 * time.Minute)
+
+	if err := sqlDB.Ping(); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("データベースへの接続に失敗しました: %w", err)
+	}
+
+	// 既存のコネクションプールを使ってGORMを初期化
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: sqlDB,
+	}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+	if err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("GORMの初期化に失敗しました: %w", err)
+	}
+
+	return &DB{SQL: sqlDB, Gorm: gormDB}, nil
+}
+
+// Close はデータベース接続を閉じる
+func (db *DB) Close() error {
+	return db.SQL.Close()
+}
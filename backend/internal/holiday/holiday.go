@@ -0,0 +1,119 @@
+package holiday
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// dateLayout は祝日一覧の日付の形式
+const dateLayout = "2006-01-02"
+
+// Holiday は祝日1日分
+type Holiday struct {
+	Date time.Time // 年月日のみ使用する
+	Name string
+}
+
+// Provider は祝日の判定を提供する
+type Provider interface {
+	// Lookup 指定日（dateの年月日）が祝日であれば祝日名を返す
+	Lookup(date time.Time) (string, bool)
+}
+
+// Source は祝日カレンダーの取得元（独自の祝日一覧を保存したテーブルなど）
+type Source interface {
+	Holidays() (Provider, error)
+}
+
+// Checker 祝日判定関数を作成する
+func Checker(p Provider) func(date time.Time) bool {
+	return func(date time.Time) bool {
+		_, ok := p.Lookup(date)
+		return ok
+	}
+}
+
+// Between 期間内（startの年月日からendの年月日の前日まで）の祝日一覧
+func Between(p Provider, start, end time.Time) []Holiday {
+	var holidays []Holiday
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	for ; day.Before(last); day = day.AddDate(0, 0, 1) {
+		if name, ok := p.Lookup(day); ok {
+			holidays = append(holidays, Holiday{Date: day, Name: name})
+		}
+	}
+	return holidays
+}
+
+// List は独自に登録した祝日一覧
+type List map[string]string
+
+// NewList 祝日の一覧から祝日カレンダーを作成する
+func NewList(holidays []Holiday) List {
+	list := make(List, len(holidays))
+	for _, h := range holidays {
+		list[h.Date.Format(dateLayout)] = h.Name
+	}
+	return list
+}
+
+// Lookup 指定日が一覧に含まれていれば祝日名を返す
+func (l List) Lookup(date time.Time) (string, bool) {
+	name, ok := l[date.Format(dateLayout)]
+	return name, ok
+}
+
+// merged は複数の祝日カレンダーを合成したもの
+type merged []Provider
+
+// Merge 複数の祝日カレンダーを合成する。同じ日は先に指定したものの祝日名を使う
+func Merge(providers ...Provider) Provider {
+	return merged(providers)
+}
+
+func (m merged) Lookup(date time.Time) (string, bool) {
+	for _, p := range m {
+		if name, ok := p.Lookup(date); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// ParseCSV "YYYY-MM-DD,祝日名"形式の一覧を読み込む
+// 空行と"#"で始まる行は無視する
+func ParseCSV(r io.Reader) ([]Holiday, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	var holidays []Holiday
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return holidays, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("祝日一覧の読み込みに失敗しました: %w", err)
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("祝日一覧の形式が正しくありません: %s", strings.Join(record, ","))
+		}
+
+		date, err := time.Parse(dateLayout, strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("祝日の日付が正しくありません: %s", record[0])
+		}
+		name := strings.TrimSpace(record[1])
+		if name == "" {
+			return nil, fmt.Errorf("祝日名が指定されていません: %s", record[0])
+		}
+		holidays = append(holidays, Holiday{Date: date, Name: name})
+	}
+}
@@ -0,0 +1,167 @@
+package holiday
+
+import (
+	"sync"
+	"time"
+)
+
+// 祝日を計算できる期間（春分・秋分の日の近似式の適用範囲に合わせる）
+const (
+	japanFirstYear = 2000
+	japanLastYear  = 2099
+)
+
+// Japan は「国民の祝日に関する法律」に基づく日本の祝日カレンダー
+// 振替休日・国民の休日も規則から計算する。年ごとの計算結果はキャッシュする
+type Japan struct {
+	mu    sync.Mutex
+	years map[int]map[string]string
+}
+
+func NewJapan() *Japan {
+	return &Japan{years: map[int]map[string]string{}}
+}
+
+// Lookup 指定日が祝日であれば祝日名を返す
+func (j *Japan) Lookup(date time.Time) (string, bool) {
+	year := date.Year()
+	if year < japanFirstYear || year > japanLastYear {
+		return "", false
+	}
+
+	j.mu.Lock()
+	holidays, ok := j.years[year]
+	if !ok {
+		holidays = japaneseHolidays(year)
+		j.years[year] = holidays
+	}
+	j.mu.Unlock()
+
+	name, ok := holidays[date.Format(dateLayout)]
+	return name, ok
+}
+
+// japaneseHolidays 指定年の祝日（振替休日・国民の休日を含む）
+func japaneseHolidays(year int) map[string]string {
+	national := map[string]string{}
+	add := func(month time.Month, day int, name string) {
+		national[time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format(dateLayout)] = name
+	}
+
+	add(time.January, 1, "元日")
+	add(time.January, nthMonday(year, time.January, 2), "成人の日")
+	add(time.February, 11, "建国記念の日")
+	if year >= 2020 {
+		add(time.February, 23, "天皇誕生日")
+	}
+	add(time.March, vernalEquinoxDay(year), "春分の日")
+	if year >= 2007 {
+		add(time.April, 29, "昭和の日")
+		add(time.May, 4, "みどりの日")
+	} else {
+		add(time.April, 29, "みどりの日")
+	}
+	add(time.May, 3, "憲法記念日")
+	add(time.May, 5, "こどもの日")
+
+	// 東京オリンピック・パラリンピック特措法による移動
+	switch year {
+	case 2020:
+		add(time.July, 23, "海の日")
+		add(time.July, 24, "スポーツの日")
+		add(time.August, 10, "山の日")
+	case 2021:
+		add(time.July, 22, "海の日")
+		add(time.July, 23, "スポーツの日")
+		add(time.August, 8, "山の日")
+	default:
+		if year >= 2003 {
+			add(time.July, nthMonday(year, time.July, 3), "海の日")
+		} else {
+			add(time.July, 20, "海の日")
+		}
+		if year >= 2016 {
+			add(time.August, 11, "山の日")
+		}
+		if year >= 2020 {
+			add(time.October, nthMonday(year, time.October, 2), "スポーツの日")
+		} else {
+			add(time.October, nthMonday(year, time.October, 2), "体育の日")
+		}
+	}
+
+	if year >= 2003 {
+		add(time.September, nthMonday(year, time.September, 3), "敬老の日")
+	} else {
+		add(time.September, 15, "敬老の日")
+	}
+	add(time.September, autumnalEquinoxDay(year), "秋分の日")
+	add(time.November, 3, "文化の日")
+	add(time.November, 23, "勤労感謝の日")
+	if year <= 2018 {
+		add(time.December, 23, "天皇誕生日")
+	}
+
+	// 天皇の即位に伴う休日
+	if year == 2019 {
+		add(time.May, 1, "休日（祝日扱い）")
+		add(time.October, 22, "休日（祝日扱い）")
+	}
+
+	holidays := make(map[string]string, len(national)+4)
+	for key, name := range national {
+		holidays[key] = name
+	}
+
+	// 振替休日: 祝日が日曜日の場合、その後の最も近い祝日でない日
+	for key := range national {
+		date, _ := time.Parse(dateLayout, key)
+		if date.Weekday() != time.Sunday {
+			continue
+		}
+		substitute := date.AddDate(0, 0, 1)
+		for {
+			if _, ok := national[substitute.Format(dateLayout)]; !ok {
+				break
+			}
+			substitute = substitute.AddDate(0, 0, 1)
+		}
+		holidays[substitute.Format(dateLayout)] = "振替休日"
+	}
+
+	// 国民の休日: 前日と翌日が祝日である平日（祝日・振替休日・日曜日を除く）
+	start := time.Date(year, time.January, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		key := day.Format(dateLayout)
+		if _, ok := holidays[key]; ok || day.Weekday() == time.Sunday {
+			continue
+		}
+		_, prev := national[day.AddDate(0, 0, -1).Format(dateLayout)]
+		_, next := national[day.AddDate(0, 0, 1).Format(dateLayout)]
+		if prev && next {
+			holidays[key] = "国民の休日"
+		}
+	}
+
+	return holidays
+}
+
+// nthMonday 指定月の第n月曜日の日付
+func nthMonday(year int, month time.Month, n int) int {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(time.Monday) - int(first.Weekday()) + 7) % 7
+	return 1 + offset + (n-1)*7
+}
+
+// vernalEquinoxDay 春分の日（1980〜2099年の近似式）
+func vernalEquinoxDay(year int) int {
+	y := year - 1980
+	return int(20.8431+0.242194*float64(y)) - y/4
+}
+
+// autumnalEquinoxDay 秋分の日（1980〜2099年の近似式）
+func autumnalEquinoxDay(year int) int {
+	y := year - 1980
+	return int(23.2488+0.242194*float64(y)) - y/4
+}
@@ -0,0 +1,146 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+// japanFixtures は内閣府の「国民の祝日」一覧に基づく年ごとの祝日
+var japanFixtures = map[int][]string{
+	// 天皇の即位に伴う休日と、それに挟まれた国民の休日（4/30・5/2）がある年
+	2019: {
+		"2019-01-01 元日",
+		"2019-01-14 成人の日",
+		"2019-02-11 建国記念の日",
+		"2019-03-21 春分の日",
+		"2019-04-29 昭和の日",
+		"2019-04-30 国民の休日",
+		"2019-05-01 休日（祝日扱い）",
+		"2019-05-02 国民の休日",
+		"2019-05-03 憲法記念日",
+		"2019-05-04 みどりの日",
+		"2019-05-05 こどもの日",
+		"2019-05-06 振替休日",
+		"2019-07-15 海の日",
+		"2019-08-11 山の日",
+		"2019-08-12 振替休日",
+		"2019-09-16 敬老の日",
+		"2019-09-23 秋分の日",
+		"2019-10-14 体育の日",
+		"2019-10-22 休日（祝日扱い）",
+		"2019-11-03 文化の日",
+		"2019-11-04 振替休日",
+		"2019-11-23 勤労感謝の日",
+	},
+	// 日曜日の祝日が多く、秋分の日の振替休日がある年
+	2024: {
+		"2024-01-01 元日",
+		"2024-01-08 成人の日",
+		"2024-02-11 建国記念の日",
+		"2024-02-12 振替休日",
+		"2024-02-23 天皇誕生日",
+		"2024-03-20 春分の日",
+		"2024-04-29 昭和の日",
+		"2024-05-03 憲法記念日",
+		"2024-05-04 みどりの日",
+		"2024-05-05 こどもの日",
+		"2024-05-06 振替休日",
+		"2024-07-15 海の日",
+		"2024-08-11 山の日",
+		"2024-08-12 振替休日",
+		"2024-09-16 敬老の日",
+		"2024-09-22 秋分の日",
+		"2024-09-23 振替休日",
+		"2024-10-14 スポーツの日",
+		"2024-11-03 文化の日",
+		"2024-11-04 振替休日",
+		"2024-11-23 勤労感謝の日",
+	},
+	// 憲法記念日の振替休日が連休の翌日になり、敬老の日と秋分の日に挟まれた国民の休日がある年
+	2026: {
+		"2026-01-01 元日",
+		"2026-01-12 成人の日",
+		"2026-02-11 建国記念の日",
+		"2026-02-23 天皇誕生日",
+		"2026-03-20 春分の日",
+		"2026-04-29 昭和の日",
+		"2026-05-03 憲法記念日",
+		"2026-05-04 みどりの日",
+		"2026-05-05 こどもの日",
+		"2026-05-06 振替休日",
+		"2026-07-20 海の日",
+		"2026-08-11 山の日",
+		"2026-09-21 敬老の日",
+		"2026-09-22 国民の休日",
+		"2026-09-23 秋分の日",
+		"2026-10-12 スポーツの日",
+		"2026-11-03 文化の日",
+		"2026-11-23 勤労感謝の日",
+	},
+}
+
+func TestJapanHolidays(t *testing.T) {
+	japan := NewJapan()
+	for year, want := range japanFixtures {
+		holidays := Between(japan,
+			time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+		got := make([]string, len(holidays))
+		for i, h := range holidays {
+			got[i] = h.Date.Format(dateLayout) + " " + h.Name
+		}
+
+		if len(got) != len(want) {
+			t.Errorf("%d: expected %d holidays, got %d: %v", year, len(want), len(got), got)
+			continue
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("%d: expected %s, got %s", year, want[i], got[i])
+			}
+		}
+	}
+}
+
+func TestJapanRules(t *testing.T) {
+	japan := NewJapan()
+	tests := []struct {
+		name string
+		date time.Time
+		want string // 空は祝日でない
+	}{
+		// 春分・秋分の日は近似式で求める
+		{"vernal equinox 2019", time.Date(2019, 3, 21, 0, 0, 0, 0, time.UTC), "春分の日"},
+		{"vernal equinox 2024", time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), "春分の日"},
+		{"day after vernal equinox 2024", time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC), ""},
+		{"autumnal equinox 2026", time.Date(2026, 9, 23, 0, 0, 0, 0, time.UTC), "秋分の日"},
+		// ハッピーマンデー（第n月曜日）
+		{"coming of age day is second monday", time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC), "成人の日"},
+		{"january 15 is no longer a holiday", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), ""},
+		{"marine day is third monday", time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC), "海の日"},
+		{"respect for the aged day is third monday", time.Date(2019, 9, 16, 0, 0, 0, 0, time.UTC), "敬老の日"},
+		{"sports day is second monday", time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), "スポーツの日"},
+		// 振替休日は日曜日の祝日の後の最も近い祝日でない日
+		{"substitute after sunday holiday", time.Date(2024, 2, 12, 0, 0, 0, 0, time.UTC), "振替休日"},
+		{"substitute skips following holidays", time.Date(2026, 5, 6, 0, 0, 0, 0, time.UTC), "振替休日"},
+		{"saturday holiday has no substitute", time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC), ""},
+		// 国民の休日は祝日に挟まれた平日
+		{"citizens holiday between holidays", time.Date(2026, 9, 22, 0, 0, 0, 0, time.UTC), "国民の休日"},
+		{"citizens holiday in 2019 golden week", time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC), "国民の休日"},
+		// 時刻に関わらず年月日で判定する
+		{"time of day is ignored", time.Date(2024, 5, 3, 23, 59, 0, 0, time.UTC), "憲法記念日"},
+		// 計算できる期間外は祝日なし
+		{"before supported range", time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC), ""},
+		{"after supported range", time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := japan.Lookup(tt.date)
+			if ok != (tt.want != "") || name != tt.want {
+				t.Fatalf("expected %q, got %q (holiday=%v)", tt.want, name, ok)
+			}
+		})
+	}
+}
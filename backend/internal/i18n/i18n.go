@@ -0,0 +1,58 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Lang はメッセージの言語
+type Lang string
+
+const (
+	Japanese Lang = "ja"
+	English  Lang = "en"
+
+	// Default はAccept-Languageで対応言語が指定されていない場合の言語
+	Default = Japanese
+)
+
+// catalogs は言語ごとのメッセージ（キー → fmt形式のテンプレート）
+var catalogs = map[Lang]map[string]string{
+	Japanese: japanese,
+	English:  english,
+}
+
+// Negotiate Accept-Languageヘッダーから対応言語を選ぶ
+// ヘッダーは優先度の高い順に並んでいるものとして、最初に見つかった対応言語を使う
+func Negotiate(acceptLanguage string) Lang {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		if _, ok := catalogs[Lang(primary)]; ok {
+			return Lang(primary)
+		}
+	}
+	return Default
+}
+
+// Message キーに対応するメッセージを指定言語で返す
+// 指定言語にない場合は既定の言語、それもない場合はキーをそのまま返す
+func Message(lang Lang, key string, args ...interface{}) string {
+	template, ok := catalogs[lang][key]
+	if !ok {
+		template, ok = catalogs[Default][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return template
+	}
+	return fmt.Sprintf(template, args...)
+}
+
+// Has キーに対応するメッセージが既定の言語に登録されているかどうか
+func Has(key string) bool {
+	_, ok := catalogs[Default][key]
+	return ok
+}
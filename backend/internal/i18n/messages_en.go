@@ -0,0 +1,137 @@
+package i18n
+
+// english は英語のメッセージ
+var english = map[string]string{
+	"code.INVALID_PARAMETERS": "The request is invalid",
+	"code.UNAUTHORIZED":       "Authentication is required",
+	"code.INVALID_TOKEN":      "The token is invalid",
+	"code.TOKEN_EXPIRED":      "The token has expired",
+	"code.FORBIDDEN":          "You are not allowed to perform this operation",
+	"code.NOT_FOUND":          "The requested resource was not found",
+	"code.CONFLICT":           "The request conflicts with another operation",
+	"code.SERVER_ERROR":       "An internal server error occurred",
+
+	"code.TEMPORARY_BOOKING_LIMIT_EXCEEDED": "You have reached the maximum number of temporary bookings",
+
+	"request.invalid_format":             "The request format is invalid",
+	"request.invalid_filters":            "The search filters are invalid",
+	"request.booking_id_required":        "A booking ID is required",
+	"request.time_required":              "Start time and end time are required",
+	"request.end_before_start":           "End time must be after start time",
+	"request.past_start":                 "Bookings cannot be made in the past",
+	"request.invalid_booking_type":       "The booking type is invalid",
+	"request.invalid_start_time":         "The start time format is invalid",
+	"request.invalid_end_time":           "The end time format is invalid",
+	"request.date_range_required":        "Start and end dates are required",
+	"request.invalid_date":               "Dates must be in YYYY-MM-DD format",
+	"request.invalid_year":               "The year is invalid",
+	"request.search_query_required":      "A search query is required",
+	"request.change_request_id_required": "A change request ID is required",
+	"request.series_id_required":         "A booking series ID is required",
+	"request.invalid_exclude_booking_id": "The booking ID to exclude is invalid",
+
+	"auth.admin_required":           "Administrator privileges are required",
+	"auth.register_fields_required": "Email, password and full name are required",
+	"auth.invalid_email":            "The email address format is invalid",
+	"auth.password_too_short":       "Password must be at least %d characters",
+	"auth.password_too_long":        "Password must be at most %d bytes",
+	"auth.login_fields_required":    "Email and password are required",
+	"auth.refresh_token_required":   "A refresh token is required",
+	"auth.email_exists":             "This email address is already registered",
+	"auth.invalid_credentials":      "The email address or password is incorrect",
+	"auth.refresh_token_expired":    "The refresh token has expired. Please log in again",
+	"auth.invalid_refresh_token":    "The refresh token is invalid",
+
+	"password_reset.email_required":  "An email address is required",
+	"password_reset.fields_required": "A token and a new password are required",
+	"password_reset.invalid_token":   "The reset token is invalid or has expired",
+
+	"booking.not_found":                    "The booking was not found",
+	"booking.user_not_found":               "The specified user was not found",
+	"booking.not_owner":                    "You are not allowed to operate on this booking",
+	"booking.not_cancellable":              "This booking cannot be cancelled",
+	"booking.time_slot_unavailable":        "The selected time slot is already booked",
+	"booking.time_slot_held":               "The selected time slot is held for another booking's change request",
+	"booking.invalid_option":               "An invalid option was specified",
+	"booking.keep_limit_exceeded":          "The keep limit for this time slot has been reached",
+	"booking.not_temporary":                "Only temporary bookings can be confirmed",
+	"booking.temporary_not_approved":       "Only approved temporary bookings can be confirmed",
+	"booking.invalid_type_transition":      "A confirmed booking cannot be changed back to temporary",
+	"booking.confirmation_deadline_passed": "The confirmation deadline for this temporary booking has passed",
+	"booking.not_first_keep":               "Only the first keep can be confirmed",
+	"booking.decision_reason_required":     "A reason is required",
+	"booking.not_approvable":               "Only pending bookings can be approved",
+	"booking.not_rejectable":               "Only pending bookings can be rejected",
+	"booking.invalid_status":               "The booking status is invalid",
+	"booking.invalid_type":                 "The booking type is invalid",
+	"booking.invalid_status_transition":    "The status of this booking cannot be changed",
+	"booking.invalid_action":               "The action is invalid",
+	"booking.bulk_ids_required":            "A list of booking IDs is required",
+	"booking.bulk_limit_exceeded":          "Up to %d bookings can be processed at once",
+	"booking.bulk_rolled_back":             "Rolled back because another booking in the batch failed",
+
+	"booking_change.not_found":              "The change request was not found",
+	"booking_change.nothing_requested":      "Specify a new time or options to change",
+	"booking_change.already_pending":        "This booking already has a change request awaiting review",
+	"booking_change.not_pending":            "Only change requests awaiting review can be accepted or declined",
+	"booking_change.booking_not_changeable": "Changes can only be requested for active bookings that have not started",
+	"booking_change.invalid_status":         "The change request status is invalid",
+
+	"booking_series.not_found":             "The booking series was not found",
+	"booking_series.invalid_frequency":     "Frequency must be daily, weekly or monthly",
+	"booking_series.invalid_interval":      "Interval must be 1 or greater",
+	"booking_series.end_required":          "Specify either an occurrence count or an end date",
+	"booking_series.invalid_until":         "The end date must be in YYYY-MM-DD format and not before the first occurrence",
+	"booking_series.invalid_exception":     "Exception dates must be in YYYY-MM-DD format",
+	"booking_series.too_many_occurrences":  "A booking series can have up to %d occurrences",
+	"booking_series.no_occurrences":        "No dates match the recurrence rule",
+	"booking_series.invalid_scope":         "Scope must be this, following or all",
+	"booking_series.booking_required":      "Specify the booking of the occurrence to start from",
+	"booking_series.booking_not_in_series": "The booking is not an occurrence of this series",
+	"booking_series.nothing_to_update":     "Specify a new time or purpose to change",
+
+	"validation.date_range":              "End time must be after start time",
+	"validation.past_date":               "Bookings cannot be made in the past",
+	"validation.max_advance":             "Bookings can be made up to %d days in advance",
+	"validation.minimum_duration":        "Bookings must be at least %g hours long",
+	"validation.booking_interval_start":  "Start time must be on a %d-minute boundary",
+	"validation.booking_interval_end":    "End time must be on a %d-minute boundary",
+	"validation.business_hours":          "The selected time is outside business hours",
+	"validation.temporary_booking_limit": "You can hold at most %d temporary bookings",
+
+	"rate_plan.not_found":        "The rate plan was not found",
+	"rate_plan.name_required":    "A name is required",
+	"rate_plan.days_required":    "Specify the days of week or holidays the plan applies to",
+	"rate_plan.invalid_weekday":  "Days of week must be between 0 (Sunday) and 6 (Saturday)",
+	"rate_plan.invalid_start":    "Start time must be in HH:MM format",
+	"rate_plan.invalid_end":      "End time must be in HH:MM format",
+	"rate_plan.end_before_start": "End time must be after start time",
+	"rate_plan.negative_rate":    "The rate must be zero or more",
+
+	"business_hours.not_found":         "The business hours entry was not found",
+	"business_hours.invalid_weekday":   "The day of week must be between 0 (Sunday) and 6 (Saturday)",
+	"business_hours.invalid_date":      "Dates must be in YYYY-MM-DD format",
+	"business_hours.invalid_kind":      "Kind must be one of weekly, date or holiday",
+	"business_hours.invalid_open":      "Opening time must be in HH:MM format",
+	"business_hours.invalid_close":     "Closing time must be in HH:MM format",
+	"business_hours.close_before_open": "Closing time must be after opening time",
+	"business_hours.duplicate":         "Business hours for the same day of week or date already exist",
+
+	"holiday.not_found":      "The holiday was not found",
+	"holiday.empty":          "There are no holidays to import",
+	"holiday.name_required":  "A holiday name is required",
+	"holiday.duplicate_date": "Duplicate date: %s",
+	"holiday.invalid_csv":    "The holiday list format is invalid",
+
+	"window_search.invalid_duration":    "Duration must be between 1 and 1440 minutes",
+	"window_search.invalid_granularity": "Granularity must be 10, 30 or 60 minutes",
+	"window_search.invalid_from":        "The preferred start time must be in HH:MM format",
+	"window_search.invalid_until":       "The preferred end time must be in HH:MM format",
+	"window_search.until_before_from":   "The preferred end time must be after the preferred start time",
+	"window_search.range_too_long":      "The search period must be %d days or less",
+
+	"availability.booked":                   "The selected time slot is already booked",
+	"availability.booked_with_alternatives": "The selected time slot is already booked. Nearby available slots are suggested",
+	"availability.held":                     "The selected time slot is held by a booking change request",
+	"availability.held_with_alternatives":   "The selected time slot is held by a booking change request. Nearby available slots are suggested",
+}
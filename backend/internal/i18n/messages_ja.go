@@ -0,0 +1,151 @@
+package i18n
+
+// japanese は日本語のメッセージ
+var japanese = map[string]string{
+	// エラーコードごとの既定のメッセージ
+	"code.INVALID_PARAMETERS": "リクエストの内容が正しくありません",
+	"code.UNAUTHORIZED":       "認証が必要です",
+	"code.INVALID_TOKEN":      "トークンが無効です",
+	"code.TOKEN_EXPIRED":      "トークンの有効期限が切れています",
+	"code.FORBIDDEN":          "この操作を行う権限がありません",
+	"code.NOT_FOUND":          "指定されたリソースが見つかりません",
+	"code.CONFLICT":           "他の操作と競合しました",
+	"code.SERVER_ERROR":       "サーバーでエラーが発生しました",
+
+	// 業務ルール違反のうち専用のエラーコードを持つもの
+	"code.TEMPORARY_BOOKING_LIMIT_EXCEEDED": "仮予約数が上限に達しています",
+
+	// リクエストの検証
+	"request.invalid_format":             "リクエストの形式が正しくありません",
+	"request.invalid_filters":            "検索条件が正しくありません",
+	"request.booking_id_required":        "予約IDが必要です",
+	"request.time_required":              "開始時間と終了時間は必須です",
+	"request.end_before_start":           "終了時間は開始時間より後である必要があります",
+	"request.past_start":                 "過去の日時は予約できません",
+	"request.invalid_booking_type":       "予約タイプが正しくありません",
+	"request.invalid_start_time":         "開始時間の形式が正しくありません",
+	"request.invalid_end_time":           "終了時間の形式が正しくありません",
+	"request.date_range_required":        "開始日と終了日は必須です",
+	"request.invalid_date":               "日付はYYYY-MM-DD形式で指定してください",
+	"request.invalid_year":               "年の指定が正しくありません",
+	"request.search_query_required":      "検索クエリが必要です",
+	"request.change_request_id_required": "変更申請IDが必要です",
+	"request.series_id_required":         "定期予約IDが必要です",
+	"request.invalid_exclude_booking_id": "除外する予約IDが正しくありません",
+
+	// 認証
+	"auth.admin_required":           "管理者権限が必要です",
+	"auth.register_fields_required": "メールアドレス、パスワード、氏名は必須です",
+	"auth.invalid_email":            "メールアドレスの形式が正しくありません",
+	"auth.password_too_short":       "パスワードは%d文字以上で入力してください",
+	"auth.password_too_long":        "パスワードは%dバイト以内で入力してください",
+	"auth.login_fields_required":    "メールアドレスとパスワードが必要です",
+	"auth.refresh_token_required":   "リフレッシュトークンが必要です",
+	"auth.email_exists":             "このメールアドレスは既に登録されています",
+	"auth.invalid_credentials":      "メールアドレスまたはパスワードが正しくありません",
+	"auth.refresh_token_expired":    "リフレッシュトークンの期限が切れています。再ログインが必要です",
+	"auth.invalid_refresh_token":    "無効なリフレッシュトークンです",
+
+	// パスワードリセット
+	"password_reset.email_required":  "メールアドレスは必須です",
+	"password_reset.fields_required": "トークンと新しいパスワードは必須です",
+	"password_reset.invalid_token":   "無効または期限切れのリセットトークンです",
+
+	// 予約
+	"booking.not_found":                    "予約が見つかりません",
+	"booking.user_not_found":               "指定されたユーザーが見つかりません",
+	"booking.not_owner":                    "この予約を操作する権限がありません",
+	"booking.not_cancellable":              "この予約はキャンセルできません",
+	"booking.time_slot_unavailable":        "選択された時間帯には既に予約があります",
+	"booking.time_slot_held":               "選択された時間帯は他の予約の変更申請で仮押さえされています",
+	"booking.invalid_option":               "無効なオプションが指定されています",
+	"booking.keep_limit_exceeded":          "この時間帯のキープ数が上限に達しています",
+	"booking.not_temporary":                "仮予約ではないため、本予約への変更はできません",
+	"booking.temporary_not_approved":       "承認済みの仮予約のみ本予約に変更できます",
+	"booking.invalid_type_transition":      "本予約から仮予約への変更はできません",
+	"booking.confirmation_deadline_passed": "仮予約の確認期限を過ぎています",
+	"booking.not_first_keep":               "第一予約の仮予約のみ本予約に変更できます",
+	"booking.decision_reason_required":     "理由は必須です",
+	"booking.not_approvable":               "承認待ち状態の予約のみ承認できます",
+	"booking.not_rejectable":               "承認待ち状態の予約のみ拒否できます",
+	"booking.invalid_status":               "ステータスが正しくありません",
+	"booking.invalid_type":                 "予約タイプが正しくありません",
+	"booking.invalid_status_transition":    "この予約のステータスは変更できません",
+	"booking.invalid_action":               "操作の指定が正しくありません",
+	"booking.bulk_ids_required":            "予約IDリストが必要です",
+	"booking.bulk_limit_exceeded":          "一度に処理できる予約は%d件までです",
+	"booking.bulk_rolled_back":             "他の予約の処理に失敗したため取り消されました",
+
+	// 予約変更申請
+	"booking_change.not_found":              "変更申請が見つかりません",
+	"booking_change.nothing_requested":      "変更する日時またはオプションを指定してください",
+	"booking_change.already_pending":        "この予約には確認待ちの変更申請があります",
+	"booking_change.not_pending":            "確認待ちの変更申請のみ承認・却下できます",
+	"booking_change.booking_not_changeable": "利用開始前の有効な予約のみ変更を申請できます",
+	"booking_change.invalid_status":         "変更申請のステータスが正しくありません",
+
+	// 定期予約
+	"booking_series.not_found":             "定期予約が見つかりません",
+	"booking_series.invalid_frequency":     "繰り返しの単位はdaily・weekly・monthlyのいずれかで指定してください",
+	"booking_series.invalid_interval":      "繰り返しの間隔は1以上で指定してください",
+	"booking_series.end_required":          "繰り返しの回数または最終日を指定してください",
+	"booking_series.invalid_until":         "最終日はYYYY-MM-DD形式で、初回以降の日付を指定してください",
+	"booking_series.invalid_exception":     "除外日はYYYY-MM-DD形式で指定してください",
+	"booking_series.too_many_occurrences":  "定期予約で作成できる回は%d回までです",
+	"booking_series.no_occurrences":        "繰り返しの規則に該当する日がありません",
+	"booking_series.invalid_scope":         "対象範囲はthis・following・allのいずれかで指定してください",
+	"booking_series.booking_required":      "起点とする回の予約IDを指定してください",
+	"booking_series.booking_not_in_series": "指定の予約はこの定期予約の回ではありません",
+	"booking_series.nothing_to_update":     "変更する日時または利用目的を指定してください",
+
+	// 業務ルール
+	"validation.date_range":              "終了時間は開始時間より後である必要があります",
+	"validation.past_date":               "過去の日時は予約できません",
+	"validation.max_advance":             "予約は%d日先まで可能です",
+	"validation.minimum_duration":        "予約時間は最低%g時間以上必要です",
+	"validation.booking_interval_start":  "開始時間は%d分間隔で設定してください",
+	"validation.booking_interval_end":    "終了時間は%d分間隔で設定してください",
+	"validation.business_hours":          "営業時間外の時間帯は予約できません",
+	"validation.temporary_booking_limit": "仮予約は最大%d件まで申請できます",
+
+	// 料金プラン
+	"rate_plan.not_found":        "料金プランが見つかりません",
+	"rate_plan.name_required":    "名前は必須です",
+	"rate_plan.days_required":    "適用する曜日または祝日を指定してください",
+	"rate_plan.invalid_weekday":  "曜日は0（日曜）〜6（土曜）で指定してください",
+	"rate_plan.invalid_start":    "開始時刻はHH:MM形式で指定してください",
+	"rate_plan.invalid_end":      "終了時刻はHH:MM形式で指定してください",
+	"rate_plan.end_before_start": "終了時刻は開始時刻より後である必要があります",
+	"rate_plan.negative_rate":    "料金は0以上で指定してください",
+
+	// 営業時間
+	"business_hours.not_found":         "営業時間設定が見つかりません",
+	"business_hours.invalid_weekday":   "曜日は0（日曜）〜6（土曜）で指定してください",
+	"business_hours.invalid_date":      "日付はYYYY-MM-DD形式で指定してください",
+	"business_hours.invalid_kind":      "種類はweekly・date・holidayのいずれかを指定してください",
+	"business_hours.invalid_open":      "開店時刻はHH:MM形式で指定してください",
+	"business_hours.invalid_close":     "閉店時刻はHH:MM形式で指定してください",
+	"business_hours.close_before_open": "閉店時刻は開店時刻より後である必要があります",
+	"business_hours.duplicate":         "同じ曜日・日付の営業時間が既に登録されています",
+
+	// 休日
+	"holiday.not_found":      "休日が見つかりません",
+	"holiday.empty":          "取り込む休日がありません",
+	"holiday.name_required":  "休日名は必須です",
+	"holiday.duplicate_date": "日付が重複しています: %s",
+	"holiday.invalid_csv":    "祝日一覧の形式が正しくありません",
+
+	// 空き枠検索
+	"window_search.invalid_duration":    "予約したい長さは1〜1440分で指定してください",
+	"window_search.invalid_granularity": "刻みは10・30・60分のいずれかを指定してください",
+	"window_search.invalid_from":        "希望の開始時刻はHH:MM形式で指定してください",
+	"window_search.invalid_until":       "希望の終了時刻はHH:MM形式で指定してください",
+	"window_search.until_before_from":   "希望の終了時刻は開始時刻より後である必要があります",
+	"window_search.range_too_long":      "検索期間は%d日以内で指定してください",
+
+	// 空き状況確認
+	"availability.booked":                   "選択された時間帯には既に予約があります",
+	"availability.booked_with_alternatives": "選択された時間帯には既に予約があります。近い時間帯の空き枠を提案します",
+	"availability.held":                     "選択された時間帯は予約の変更申請で仮押さえされています",
+	"availability.held_with_alternatives":   "選択された時間帯は予約の変更申請で仮押さえされています。近い時間帯の空き枠を提案します",
+}
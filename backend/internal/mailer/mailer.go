@@ -0,0 +1,77 @@
+package mailer
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message は送信するメール
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer はメール送信の抽象
+// 本番環境ではSES等の実装に差し替える
+type Mailer interface {
+	Send(msg Message) error
+}
+
+// New は設定値に応じたMailerを生成する
+// driverは "log" または "file" をサポートする
+func New(driver, from, outputDir string) (Mailer, error) {
+	switch driver {
+	case "", "log":
+		return &LogMailer{From: from}, nil
+	case "file":
+		if err := os.MkdirAll(outputDir, 0o755); err != nil {
+			return nil, fmt.Errorf("メール出力ディレクトリの作成に失敗しました: %w", err)
+		}
+		return &FileMailer{From: from, Dir: outputDir}, nil
+	default:
+		return nil, fmt.Errorf("未対応のメールドライバーです: %s", driver)
+	}
+}
+
+// LogMailer はメールを送信せずログに出力する開発用の実装
+type LogMailer struct {
+	From string
+}
+
+// Send はメール内容をログに出力する
+func (m *LogMailer) Send(msg Message) error {
+	log.Printf("[mail] from=%s to=%s subject=%q\n%s", m.From, msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// FileMailer はメールを.emlファイルとして書き出す開発用の実装
+type FileMailer struct {
+	From string
+	Dir  string
+}
+
+// Send はメール内容をファイルに書き出す
+func (m *FileMailer) Send(msg Message) error {
+	now := time.Now()
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405"), uuid.NewString())
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.From)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(msg.Body)
+
+	if err := os.WriteFile(filepath.Join(m.Dir, name), []byte(b.String()), 0o600); err != nil {
+		return fmt.Errorf("メールファイルの書き込みに失敗しました: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -31,6 +34,47 @@ func (User) TableName() string {
 	return "users"
 }
 
+// RefreshToken モデルは発行済みリフレッシュトークンを表します
+// トークン本体は保存せず、SHA-256ハッシュのみを保持します
+type RefreshToken struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null" json:"userId"`
+	TokenHash  string     `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"`
+	ExpiresAt  time.Time  `gorm:"not null" json:"expiresAt"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	ReplacedBy *uuid.UUID `gorm:"type:uuid" json:"replacedBy,omitempty"`
+	UserAgent  string     `gorm:"type:text" json:"userAgent,omitempty"`
+	IPAddress  string     `gorm:"type:varchar(45)" json:"ipAddress,omitempty"`
+	CreatedAt  time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+
+	// リレーション
+	User *User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName はGORMがテーブル名として使用する名前を指定します
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+// PasswordResetToken モデルはパスワードリセット用トークンを表します
+// トークン本体は保存せず、SHA-256ハッシュのみを保持します
+type PasswordResetToken struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null" json:"userId"`
+	TokenHash string     `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expiresAt"`
+	UsedAt    *time.Time `json:"usedAt,omitempty"`
+	CreatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+
+	// リレーション
+	User *User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName はGORMがテーブル名として使用する名前を指定します
+func (PasswordResetToken) TableName() string {
+	return "password_reset_tokens"
+}
+
 // BookingStatus は予約のステータスを表す型
 type BookingStatus string
 
@@ -53,23 +97,154 @@ const (
 	BookingTypeConfirmed BookingType = "confirmed"
 )
 
+// BookingState は予約のステータスと予約タイプの組を表します
+// 新規作成前の予約はゼロ値で表します
+type BookingState struct {
+	Status BookingStatus
+	Type   BookingType
+}
+
+// bookingStatusTransitions は遷移元ステータスごとの遷移可能なステータス
+// 空文字の遷移元は新規作成を表し、拒否・キャンセルは終端状態とする
+var bookingStatusTransitions = map[BookingStatus][]BookingStatus{
+	"":                    {BookingStatusPending, BookingStatusApproved},
+	BookingStatusPending:  {BookingStatusApproved, BookingStatusRejected, BookingStatusCancelled},
+	BookingStatusApproved: {BookingStatusCancelled},
+}
+
+// Valid は定義済みのステータスかどうかを返します
+func (s BookingStatus) Valid() bool {
+	switch s {
+	case BookingStatusPending, BookingStatusApproved, BookingStatusRejected, BookingStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// IsActive は時間枠を占有するステータスかどうかを返します
+func (s BookingStatus) IsActive() bool {
+	return s == BookingStatusPending || s == BookingStatusApproved
+}
+
+// CanTransitionTo は指定のステータスに遷移できるかどうかを返します
+// 同じステータスへの遷移（承認済みの再承認・キャンセル済みの再キャンセルなど）は許可しません
+func (s BookingStatus) CanTransitionTo(next BookingStatus) bool {
+	for _, allowed := range bookingStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Valid は定義済みの予約タイプかどうかを返します
+func (t BookingType) Valid() bool {
+	return t == BookingTypeTemporary || t == BookingTypeConfirmed
+}
+
+// CanTransitionTo は指定の予約タイプに変更できるかどうかを返します
+// 新規作成時はどちらのタイプも選べ、作成後は仮予約から本予約への変更のみ許可します
+func (t BookingType) CanTransitionTo(next BookingType) bool {
+	if t == "" || t == next {
+		return next.Valid()
+	}
+	return t == BookingTypeTemporary && next == BookingTypeConfirmed
+}
+
+const (
+	// MaxKeepCount は同一時間帯で受け付ける予約の最大数（第一予約＋キープ）
+	MaxKeepCount = 3
+	// KeepOrderFirst は第一予約のキープ順序
+	KeepOrderFirst = 1
+)
+
+// PriceLineItemKind は料金明細の種類を表す型
+type PriceLineItemKind string
+
+const (
+	// PriceLineItemStudio はスタジオ利用料
+	PriceLineItemStudio PriceLineItemKind = "studio"
+	// PriceLineItemOption はオプション料金
+	PriceLineItemOption PriceLineItemKind = "option"
+)
+
+// PriceLineItem は料金明細の1行を表します
+type PriceLineItem struct {
+	Kind      PriceLineItemKind `json:"kind"`
+	Label     string            `json:"label"`
+	OptionID  *uuid.UUID        `json:"optionId,omitempty"`
+	UnitPrice float64           `json:"unitPrice"`
+	Quantity  float64           `json:"quantity"`
+	Unit      string            `json:"unit"`
+	Amount    int               `json:"amount"`
+	StartTime *time.Time        `json:"startTime,omitempty"` // スタジオ利用料の区間
+	EndTime   *time.Time        `json:"endTime,omitempty"`
+}
+
+// PriceBreakdown は予約時点の料金内訳のスナップショットを表します
+// 料金設定やオプション単価が変更されても、予約済みの金額は変わりません
+type PriceBreakdown struct {
+	Items         []PriceLineItem `json:"items"`
+	BillableHours float64         `json:"billableHours"`
+	BaseAmount    int             `json:"baseAmount"` // スタジオ利用料の合計（オプションを除く）
+	Subtotal      int             `json:"subtotal"`
+	TaxRate       float64         `json:"taxRate"`
+	TaxRounding   string          `json:"taxRounding"`
+	TaxAmount     int             `json:"taxAmount"`
+	Total         int             `json:"total"`
+	CalculatedAt  time.Time       `json:"calculatedAt"`
+}
+
+// Value はJSONBカラムへの保存形式に変換します
+func (b PriceBreakdown) Value() (driver.Value, error) {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan はJSONBカラムの値を読み込みます
+func (b *PriceBreakdown) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("料金内訳の形式が正しくありません: %T", value)
+	}
+	return json.Unmarshal(data, b)
+}
+
 // Booking モデルは予約情報を表します
 type Booking struct {
-	ID                     uuid.UUID     `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
-	UserID                 *uuid.UUID    `gorm:"type:uuid" json:"userId,omitempty"`
-	StartTime              time.Time     `gorm:"not null" json:"startTime"`
-	EndTime                time.Time     `gorm:"not null" json:"endTime"`
-	Status                 BookingStatus `gorm:"type:varchar(20);not null" json:"status"`
-	BookingType            BookingType   `gorm:"type:varchar(20);not null" json:"bookingType"`
-	Purpose                string        `gorm:"type:text" json:"purpose,omitempty"`
-	PeopleCount            int           `json:"peopleCount,omitempty"`
-	ConfirmationDeadline   *time.Time    `json:"confirmationDeadline,omitempty"`
-	AutomaticCancellation  bool          `gorm:"default:false" json:"automaticCancellation"`
-	CancellationFeePercent float64       `gorm:"default:0" json:"cancellationFeePercent"`
-	ApprovedBy             *uuid.UUID    `gorm:"type:uuid" json:"approvedBy,omitempty"`
-	ApprovedAt             *time.Time    `json:"approvedAt,omitempty"`
-	CreatedAt              time.Time     `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
-	UpdatedAt              time.Time     `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
+	ID                      uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	UserID                  *uuid.UUID      `gorm:"type:uuid" json:"userId,omitempty"`
+	StartTime               time.Time       `gorm:"not null" json:"startTime"`
+	EndTime                 time.Time       `gorm:"not null" json:"endTime"`
+	Status                  BookingStatus   `gorm:"type:varchar(20);not null" json:"status"`
+	BookingType             BookingType     `gorm:"type:varchar(20);not null" json:"bookingType"`
+	Purpose                 string          `gorm:"type:text" json:"purpose,omitempty"`
+	PeopleCount             int             `json:"peopleCount,omitempty"`
+	ConfirmationDeadline    *time.Time      `json:"confirmationDeadline,omitempty"`
+	AutomaticCancellation   bool            `gorm:"default:false" json:"automaticCancellation"`
+	CancellationFeePercent  float64         `gorm:"default:0" json:"cancellationFeePercent"`
+	CancellationFeeAmount   int             `gorm:"not null;default:0" json:"cancellationFeeAmount"`
+	SubtotalAmount          int             `gorm:"not null;default:0" json:"subtotalAmount"`
+	TaxAmount               int             `gorm:"not null;default:0" json:"taxAmount"`
+	TotalAmountIncludingTax int             `gorm:"not null;default:0" json:"totalAmountIncludingTax"`
+	PriceBreakdown          *PriceBreakdown `gorm:"type:jsonb" json:"priceBreakdown,omitempty"`
+	KeepOrder               int             `gorm:"not null;default:1" json:"keepOrder"`
+	SeriesID                *uuid.UUID      `gorm:"type:uuid" json:"seriesId,omitempty"`
+	OccurrenceDate          *time.Time      `gorm:"type:date" json:"occurrenceDate,omitempty"` // 定期予約の規則上の回の日付
+	ApprovedBy              *uuid.UUID      `gorm:"type:uuid" json:"approvedBy,omitempty"`
+	ApprovedAt              *time.Time      `json:"approvedAt,omitempty"`
+	CreatedBy               *uuid.UUID      `gorm:"type:uuid" json:"createdBy,omitempty"`
+	UpdatedBy               *uuid.UUID      `gorm:"type:uuid" json:"updatedBy,omitempty"`
+	CreatedAt               time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt               time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
 
 	// リレーション
 	User           *User              `gorm:"foreignKey:UserID" json:"user,omitempty"`
@@ -83,6 +258,82 @@ func (Booking) TableName() string {
 	return "bookings"
 }
 
+// State は予約の現在のステータスと予約タイプを返します
+func (b Booking) State() BookingState {
+	return BookingState{Status: b.Status, Type: b.BookingType}
+}
+
+// SeriesFrequency は定期予約の繰り返しの単位を表す型
+type SeriesFrequency string
+
+const (
+	// SeriesFrequencyDaily は毎日（間隔の日数ごと）
+	SeriesFrequencyDaily SeriesFrequency = "daily"
+	// SeriesFrequencyWeekly は毎週（初回と同じ曜日）
+	SeriesFrequencyWeekly SeriesFrequency = "weekly"
+	// SeriesFrequencyMonthly は毎月（初回と同じ日）
+	SeriesFrequencyMonthly SeriesFrequency = "monthly"
+)
+
+// DateList は日付（YYYY-MM-DD）の一覧を表します
+type DateList []string
+
+// Value はJSONBカラムへの保存形式に変換します
+func (l DateList) Value() (driver.Value, error) {
+	if l == nil {
+		l = DateList{}
+	}
+	data, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan はJSONBカラムの値を読み込みます
+func (l *DateList) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("日付の一覧の形式が正しくありません: %T", value)
+	}
+	return json.Unmarshal(data, l)
+}
+
+// BookingSeries モデルは定期予約（繰り返しの規則）を表します
+// 各回の予約は作成時にBookingとして登録し、SeriesIDで関連付けます
+type BookingSeries struct {
+	ID              uuid.UUID       `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	UserID          *uuid.UUID      `gorm:"type:uuid" json:"userId,omitempty"`
+	Frequency       SeriesFrequency `gorm:"type:varchar(20);not null" json:"frequency"`
+	RepeatInterval  int             `gorm:"not null;default:1" json:"interval"`
+	OccurrenceCount *int            `json:"count,omitempty"`
+	UntilDate       *time.Time      `gorm:"type:date" json:"until,omitempty"`
+	ExceptionDates  DateList        `gorm:"type:jsonb;not null" json:"exceptions"`
+	StartTime       time.Time       `gorm:"not null" json:"startTime"` // 初回の日時
+	EndTime         time.Time       `gorm:"not null" json:"endTime"`
+	BookingType     BookingType     `gorm:"type:varchar(20);not null" json:"bookingType"`
+	Purpose         string          `gorm:"type:text" json:"purpose,omitempty"`
+	CancelledAt     *time.Time      `json:"cancelledAt,omitempty"`
+	CreatedBy       *uuid.UUID      `gorm:"type:uuid" json:"createdBy,omitempty"`
+	UpdatedBy       *uuid.UUID      `gorm:"type:uuid" json:"updatedBy,omitempty"`
+	CreatedAt       time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt       time.Time       `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
+
+	// リレーション
+	User     *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Bookings []Booking `gorm:"foreignKey:SeriesID" json:"bookings,omitempty"`
+}
+
+// TableName はGORMがテーブル名として使用する名前を指定します
+func (BookingSeries) TableName() string {
+	return "booking_series"
+}
+
 // Option モデルはオプションマスタ情報を表します
 type Option struct {
 	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
@@ -103,6 +354,74 @@ func (Option) TableName() string {
 	return "options"
 }
 
+// RatePlan モデルは曜日・時間帯別の料金プランを表します
+// 日をまたぐ時間帯は日付の境界で2つのプランに分けて登録します
+type RatePlan struct {
+	ID                uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Name              string    `gorm:"type:varchar(100);not null" json:"name"`
+	WeekdayMask       int       `gorm:"type:smallint;not null" json:"weekdayMask"` // 1<<日曜 〜 1<<土曜
+	AppliesToHolidays bool      `gorm:"default:false" json:"appliesToHolidays"`
+	StartMinute       int       `gorm:"not null" json:"startMinute"` // 0時からの経過分
+	EndMinute         int       `gorm:"not null" json:"endMinute"`   // 0時からの経過分（1440で24:00）
+	HourlyRate        int       `gorm:"not null" json:"hourlyRate"`
+	Priority          int       `gorm:"not null;default:0" json:"priority"`
+	IsActive          bool      `gorm:"default:true" json:"isActive"`
+	CreatedAt         time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt         time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
+}
+
+// TableName はGORMがテーブル名として使用する名前を指定します
+func (RatePlan) TableName() string {
+	return "rate_plans"
+}
+
+// BusinessHoursKind は営業時間設定の種類を表します
+type BusinessHoursKind string
+
+const (
+	// BusinessHoursKindWeekly は曜日ごとの通常営業時間
+	BusinessHoursKindWeekly BusinessHoursKind = "weekly"
+	// BusinessHoursKindDate は特定日の営業時間（臨時休業・営業時間変更）
+	BusinessHoursKindDate BusinessHoursKind = "date"
+	// BusinessHoursKindHoliday は祝日の営業時間
+	BusinessHoursKindHoliday BusinessHoursKind = "holiday"
+)
+
+// BusinessHours モデルは営業時間設定を表します
+// 優先順位は 特定日 > 祝日 > 曜日 で、設定のない曜日は休業日として扱います
+type BusinessHours struct {
+	ID          uuid.UUID         `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Kind        BusinessHoursKind `gorm:"type:varchar(20);not null" json:"kind"`
+	Weekday     *int              `gorm:"type:smallint" json:"weekday,omitempty"` // 0: 日曜 〜 6: 土曜（weeklyのみ）
+	Date        *time.Time        `gorm:"type:date" json:"date,omitempty"`        // dateのみ
+	IsClosed    bool              `gorm:"default:false" json:"isClosed"`
+	OpenMinute  *int              `json:"openMinute,omitempty"`  // 0時からの経過分
+	CloseMinute *int              `json:"closeMinute,omitempty"` // 0時からの経過分（1440で24:00）
+	Note        string            `gorm:"type:varchar(200)" json:"note"`
+	CreatedAt   time.Time         `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt   time.Time         `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
+}
+
+// TableName はGORMがテーブル名として使用する名前を指定します
+func (BusinessHours) TableName() string {
+	return "business_hours"
+}
+
+// Holiday モデルは独自に登録した休日を表します
+// 国民の祝日は規則から計算するため、ここには会社の休業日などを登録します
+type Holiday struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	Date      time.Time `gorm:"type:date;not null;uniqueIndex" json:"date"`
+	Name      string    `gorm:"type:varchar(100);not null" json:"name"`
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
+}
+
+// TableName はGORMがテーブル名として使用する名前を指定します
+func (Holiday) TableName() string {
+	return "holidays"
+}
+
 // BookingOption モデルは予約オプション情報を表します
 type BookingOption struct {
 	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
@@ -127,7 +446,7 @@ func (BookingOption) TableName() string {
 type BookingStatusLog struct {
 	ID             uuid.UUID     `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
 	BookingID      uuid.UUID     `gorm:"type:uuid;not null" json:"bookingId"`
-	PreviousStatus BookingStatus `gorm:"type:varchar(20)" json:"previousStatus"`
+	PreviousStatus BookingStatus `gorm:"type:varchar(20);default:null" json:"previousStatus"`
 	NewStatus      BookingStatus `gorm:"type:varchar(20);not null" json:"newStatus"`
 	ChangedAt      time.Time     `gorm:"default:CURRENT_TIMESTAMP" json:"changedAt"`
 	ChangedBy      *uuid.UUID    `gorm:"type:uuid" json:"changedBy,omitempty"`
@@ -143,6 +462,89 @@ func (BookingStatusLog) TableName() string {
 	return "booking_status_logs"
 }
 
+// BookingChangeRequestStatus は予約変更申請のステータスを表す型
+type BookingChangeRequestStatus string
+
+const (
+	// ChangeRequestStatusPending は申請中ステータス（希望する時間帯を仮押さえ中）
+	ChangeRequestStatusPending BookingChangeRequestStatus = "pending"
+	// ChangeRequestStatusAccepted は承認済みステータス
+	ChangeRequestStatusAccepted BookingChangeRequestStatus = "accepted"
+	// ChangeRequestStatusDeclined は却下済みステータス
+	ChangeRequestStatusDeclined BookingChangeRequestStatus = "declined"
+	// ChangeRequestStatusCancelled は予約のキャンセルなどに伴う取り下げ
+	ChangeRequestStatusCancelled BookingChangeRequestStatus = "cancelled"
+)
+
+// RequestedOption は変更申請で希望するオプションと数量を表します
+type RequestedOption struct {
+	OptionID string  `json:"optionId"`
+	Quantity float64 `json:"quantity"`
+}
+
+// RequestedOptions は変更後のオプションの一覧を表します
+type RequestedOptions []RequestedOption
+
+// Value はJSONBカラムへの保存形式に変換します
+func (o RequestedOptions) Value() (driver.Value, error) {
+	if o == nil {
+		o = RequestedOptions{}
+	}
+	data, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan はJSONBカラムの値を読み込みます
+func (o *RequestedOptions) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("オプションの形式が正しくありません: %T", value)
+	}
+	return json.Unmarshal(data, o)
+}
+
+// BookingChangeRequest モデルは顧客からの予約変更申請を表します
+// 申請中は希望する時間帯を仮押さえし、他の予約を受け付けません
+type BookingChangeRequest struct {
+	ID                uuid.UUID                  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()" json:"id"`
+	BookingID         uuid.UUID                  `gorm:"type:uuid;not null" json:"bookingId"`
+	RequestedBy       *uuid.UUID                 `gorm:"type:uuid" json:"requestedBy,omitempty"`
+	Status            BookingChangeRequestStatus `gorm:"type:varchar(20);not null" json:"status"`
+	PreviousStartTime time.Time                  `gorm:"not null" json:"previousStartTime"`
+	PreviousEndTime   time.Time                  `gorm:"not null" json:"previousEndTime"`
+	StartTime         *time.Time                 `json:"startTime,omitempty"` // 時間帯を変更しない場合はnil
+	EndTime           *time.Time                 `json:"endTime,omitempty"`
+	Options           *RequestedOptions          `gorm:"type:jsonb" json:"options,omitempty"` // オプションを変更しない場合はnil
+	Reason            string                     `gorm:"type:text" json:"reason,omitempty"`
+	DecidedBy         *uuid.UUID                 `gorm:"type:uuid" json:"decidedBy,omitempty"`
+	DecidedAt         *time.Time                 `json:"decidedAt,omitempty"`
+	DecisionNote      string                     `gorm:"type:text" json:"decisionNote,omitempty"`
+	CreatedAt         time.Time                  `gorm:"default:CURRENT_TIMESTAMP" json:"createdAt"`
+	UpdatedAt         time.Time                  `gorm:"default:CURRENT_TIMESTAMP" json:"updatedAt"`
+
+	// リレーション
+	Booking   *Booking `gorm:"foreignKey:BookingID" json:"booking,omitempty"`
+	Requester *User    `gorm:"foreignKey:RequestedBy" json:"requester,omitempty"`
+}
+
+// TableName はGORMがテーブル名として使用する名前を指定します
+func (BookingChangeRequest) TableName() string {
+	return "booking_change_requests"
+}
+
+// ChangesSlot は時間帯の変更を含むかどうかを返します
+func (r BookingChangeRequest) ChangesSlot() bool {
+	return r.StartTime != nil && r.EndTime != nil
+}
+
 // NotificationType は通知の種類を表す型
 type NotificationType string
 
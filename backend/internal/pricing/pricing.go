@@ -0,0 +1,216 @@
+package pricing
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/holiday"
+	"github.com/zebraApp/internal/models"
+)
+
+// RoundingMode は消費税の端数処理方法
+type RoundingMode string
+
+const (
+	// RoundingFloor は切り捨て
+	RoundingFloor RoundingMode = "floor"
+	// RoundingRound は四捨五入
+	RoundingRound RoundingMode = "round"
+	// RoundingCeil は切り上げ
+	RoundingCeil RoundingMode = "ceil"
+)
+
+// ParseRoundingMode 端数処理方法の文字列を解析する
+func ParseRoundingMode(value string) (RoundingMode, error) {
+	switch mode := RoundingMode(value); mode {
+	case RoundingFloor, RoundingRound, RoundingCeil:
+		return mode, nil
+	}
+	return "", fmt.Errorf("端数処理方法が正しくありません: %s", value)
+}
+
+// apply 金額に端数処理を適用する
+func (m RoundingMode) apply(amount float64) int {
+	// 浮動小数点の誤差で端数が生じないよう、円未満6桁で丸めてから処理する
+	amount = math.Round(amount*1e6) / 1e6
+	switch m {
+	case RoundingCeil:
+		return int(math.Ceil(amount))
+	case RoundingRound:
+		return int(math.Round(amount))
+	default:
+		return int(math.Floor(amount))
+	}
+}
+
+// Config は料金計算の設定
+type Config struct {
+	HourlyRate   int            // 1時間あたりの基本料金（円、税抜）
+	MinimumHours float64        // 最低利用時間（時間）
+	TaxRate      float64        // 消費税率（例: 0.10）
+	TaxRounding  RoundingMode   // 消費税の端数処理
+	Location     *time.Location // 料金帯を判定するタイムゾーン
+}
+
+// OptionLine は料金計算に使うオプションの選択内容
+type OptionLine struct {
+	OptionID  uuid.UUID
+	Name      string
+	UnitPrice float64
+	Unit      string
+	Quantity  float64
+}
+
+// Engine はスタジオ利用料・オプション料金・消費税を計算する
+// スタジオ利用料は料金帯（曜日・時間帯別）ごとに分割して計算し、
+// どの料金帯にも該当しない時間は基本料金で計算する
+type Engine struct {
+	config   Config
+	rates    RateSource
+	holidays holiday.Source
+}
+
+// NewEngine 料金計算エンジンを作成する
+// ratesがnilの場合は基本料金のみで計算し、holidaysがnilの場合は祝日を考慮しない
+func NewEngine(config Config, rates RateSource, holidays holiday.Source) *Engine {
+	return &Engine{
+		config:   config,
+		rates:    rates,
+		holidays: holidays,
+	}
+}
+
+// RateTable 現在の料金帯一覧を取得する
+func (e *Engine) RateTable() (RateTable, error) {
+	table := RateTable{
+		DefaultName: "基本料金",
+		DefaultRate: e.config.HourlyRate,
+		Location:    e.config.Location,
+	}
+	if e.holidays != nil {
+		holidays, err := e.holidays.Holidays()
+		if err != nil {
+			return RateTable{}, fmt.Errorf("祝日の取得に失敗しました: %w", err)
+		}
+		table.IsHoliday = holiday.Checker(holidays)
+	}
+	if e.rates == nil {
+		return table, nil
+	}
+
+	bands, err := e.rates.RateBands()
+	if err != nil {
+		return RateTable{}, fmt.Errorf("料金帯の取得に失敗しました: %w", err)
+	}
+	table.Bands = bands
+	return table, nil
+}
+
+// BillableHours 課金対象の利用時間（最低利用時間に満たない場合は最低利用時間）
+func (e *Engine) BillableHours(startTime, endTime time.Time) float64 {
+	hours := endTime.Sub(startTime).Hours()
+	if hours < e.config.MinimumHours {
+		hours = e.config.MinimumHours
+	}
+	return hours
+}
+
+// BaseAmount スタジオ利用料（税抜、オプションを除く）
+func (e *Engine) BaseAmount(startTime, endTime time.Time) (int, error) {
+	table, err := e.RateTable()
+	if err != nil {
+		return 0, err
+	}
+
+	baseAmount := 0
+	for _, item := range e.studioItems(table, startTime, endTime) {
+		baseAmount += item.Amount
+	}
+	return baseAmount, nil
+}
+
+// Calculate 予約の料金内訳を計算する
+// 金額はすべて税抜で計算し、小計に対して消費税を1回だけ端数処理する
+func (e *Engine) Calculate(startTime, endTime time.Time, options []OptionLine) (models.PriceBreakdown, error) {
+	table, err := e.RateTable()
+	if err != nil {
+		return models.PriceBreakdown{}, err
+	}
+
+	items := e.studioItems(table, startTime, endTime)
+	baseAmount := 0
+	for _, item := range items {
+		baseAmount += item.Amount
+	}
+
+	subtotal := baseAmount
+	for _, option := range options {
+		optionID := option.OptionID
+		amount := int(math.Round(option.UnitPrice * option.Quantity))
+		items = append(items, models.PriceLineItem{
+			Kind:      models.PriceLineItemOption,
+			Label:     option.Name,
+			OptionID:  &optionID,
+			UnitPrice: option.UnitPrice,
+			Quantity:  option.Quantity,
+			Unit:      option.Unit,
+			Amount:    amount,
+		})
+		subtotal += amount
+	}
+
+	taxAmount := e.config.TaxRounding.apply(float64(subtotal) * e.config.TaxRate)
+
+	return models.PriceBreakdown{
+		Items:         items,
+		BillableHours: e.BillableHours(startTime, endTime),
+		BaseAmount:    baseAmount,
+		Subtotal:      subtotal,
+		TaxRate:       e.config.TaxRate,
+		TaxRounding:   string(e.config.TaxRounding),
+		TaxAmount:     taxAmount,
+		Total:         subtotal + taxAmount,
+		CalculatedAt:  time.Now(),
+	}, nil
+}
+
+// studioItems スタジオ利用料を料金帯ごとの明細に分割する
+// 最低利用時間に満たない分は、最後の区間の料金で明細を追加する
+func (e *Engine) studioItems(table RateTable, startTime, endTime time.Time) []models.PriceLineItem {
+	segments := table.Segments(startTime, endTime)
+
+	items := make([]models.PriceLineItem, 0, len(segments)+1)
+	for _, segment := range segments {
+		start, end := segment.Start, segment.End
+		items = append(items, models.PriceLineItem{
+			Kind:      models.PriceLineItemStudio,
+			Label:     fmt.Sprintf("スタジオ利用料（%s）", segment.Name),
+			UnitPrice: float64(segment.HourlyRate),
+			Quantity:  segment.Hours(),
+			Unit:      "時間",
+			Amount:    segment.Amount(),
+			StartTime: &start,
+			EndTime:   &end,
+		})
+	}
+
+	shortfall := e.config.MinimumHours - endTime.Sub(startTime).Hours()
+	if shortfall > 0 {
+		rate := table.DefaultRate
+		if len(segments) > 0 {
+			rate = segments[len(segments)-1].HourlyRate
+		}
+		items = append(items, models.PriceLineItem{
+			Kind:      models.PriceLineItemStudio,
+			Label:     "最低利用時間までの不足分",
+			UnitPrice: float64(rate),
+			Quantity:  shortfall,
+			Unit:      "時間",
+			Amount:    int(math.Round(shortfall * float64(rate))),
+		})
+	}
+
+	return items
+}
@@ -0,0 +1,194 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+)
+
+var testLocation = time.FixedZone("JST", 9*60*60)
+
+// staticRates は固定の料金帯を返す
+type staticRates []RateBand
+
+func (r staticRates) RateBands() ([]RateBand, error) {
+	return r, nil
+}
+
+// at 2025年4月の指定日・時刻（2025-04-01は火曜日）
+func at(day, hour, minute int) time.Time {
+	return time.Date(2025, 4, day, hour, minute, 0, 0, testLocation)
+}
+
+func testConfig(rounding RoundingMode) Config {
+	return Config{
+		HourlyRate:   5000,
+		MinimumHours: 2,
+		TaxRate:      0.10,
+		TaxRounding:  rounding,
+		Location:     testLocation,
+	}
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	for _, value := range []string{"floor", "round", "ceil"} {
+		if mode, err := ParseRoundingMode(value); err != nil || string(mode) != value {
+			t.Errorf("%s: expected valid mode, got %q, %v", value, mode, err)
+		}
+	}
+	if _, err := ParseRoundingMode("truncate"); err == nil {
+		t.Error("expected an error for an unknown rounding mode")
+	}
+}
+
+func TestRoundingModeApply(t *testing.T) {
+	tests := []struct {
+		mode   RoundingMode
+		amount float64
+		want   int
+	}{
+		{RoundingFloor, 1000.5, 1000},
+		{RoundingRound, 1000.5, 1001},
+		{RoundingCeil, 1000.5, 1001},
+		{RoundingFloor, 1000.4, 1000},
+		{RoundingRound, 1000.4, 1000},
+		{RoundingCeil, 1000.4, 1001},
+		// 11000 * 0.1 は浮動小数点では1100.0000000000002になるが、端数として扱わない
+		{RoundingCeil, 11000 * 0.1, 1100},
+		{RoundingFloor, 0.7 * 1000, 700},
+		// 未指定は切り捨て
+		{"", 1000.9, 1000},
+	}
+
+	for _, tt := range tests {
+		if got := tt.mode.apply(tt.amount); got != tt.want {
+			t.Errorf("%s(%v): expected %d, got %d", tt.mode, tt.amount, tt.want, got)
+		}
+	}
+}
+
+func TestEngineCalculateTaxRounding(t *testing.T) {
+	// 2時間10,000円 + オプション5円 = 小計10,005円、消費税1,000.5円
+	options := []OptionLine{{OptionID: uuid.New(), Name: "端数", UnitPrice: 5, Unit: "個", Quantity: 1}}
+
+	tests := []struct {
+		mode    RoundingMode
+		wantTax int
+	}{
+		{RoundingFloor, 1000},
+		{RoundingRound, 1001},
+		{RoundingCeil, 1001},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			breakdown, err := NewEngine(testConfig(tt.mode), nil, nil).Calculate(at(1, 10, 0), at(1, 12, 0), options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if breakdown.Subtotal != 10005 {
+				t.Fatalf("expected subtotal 10005, got %d", breakdown.Subtotal)
+			}
+			if breakdown.TaxAmount != tt.wantTax || breakdown.Total != 10005+tt.wantTax {
+				t.Fatalf("expected tax %d, got tax %d total %d", tt.wantTax, breakdown.TaxAmount, breakdown.Total)
+			}
+			if breakdown.TaxRounding != string(tt.mode) {
+				t.Errorf("expected rounding %s to be recorded, got %s", tt.mode, breakdown.TaxRounding)
+			}
+		})
+	}
+}
+
+func TestEngineCalculateMinimumHours(t *testing.T) {
+	tests := []struct {
+		name          string
+		rates         RateSource
+		start, end    time.Time
+		wantBillable  float64
+		wantBase      int
+		wantItems     int
+		wantShortfall int // 不足分の明細の金額（0は明細なし）
+	}{
+		{"longer than minimum", nil, at(1, 10, 0), at(1, 13, 0), 3, 15000, 1, 0},
+		{"exactly minimum", nil, at(1, 10, 0), at(1, 12, 0), 2, 10000, 1, 0},
+		{"one hour tops up one hour", nil, at(1, 10, 0), at(1, 11, 0), 2, 10000, 2, 5000},
+		{"ninety minutes tops up thirty", nil, at(1, 10, 0), at(1, 11, 30), 2, 10000, 2, 2500},
+		// 不足分は最後の区間の料金帯で計算する
+		{
+			"shortfall uses last segment rate",
+			staticRates{{Name: "夜間", WeekdayMask: WeekdayMask(time.Tuesday), StartMinute: 18 * 60, EndMinute: 24 * 60, HourlyRate: 8000}},
+			at(1, 17, 30), at(1, 18, 30), 2, 2500 + 4000 + 8000, 3, 8000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewEngine(testConfig(RoundingFloor), tt.rates, nil)
+			breakdown, err := engine.Calculate(tt.start, tt.end, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if breakdown.BillableHours != tt.wantBillable {
+				t.Errorf("expected %g billable hours, got %g", tt.wantBillable, breakdown.BillableHours)
+			}
+			if breakdown.BaseAmount != tt.wantBase {
+				t.Errorf("expected base amount %d, got %d", tt.wantBase, breakdown.BaseAmount)
+			}
+			if len(breakdown.Items) != tt.wantItems {
+				t.Fatalf("expected %d items, got %+v", tt.wantItems, breakdown.Items)
+			}
+			last := breakdown.Items[len(breakdown.Items)-1]
+			if tt.wantShortfall > 0 && (last.StartTime != nil || last.Amount != tt.wantShortfall) {
+				t.Errorf("expected shortfall item of %d, got %+v", tt.wantShortfall, last)
+			}
+
+			base, err := engine.BaseAmount(tt.start, tt.end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if base != tt.wantBase {
+				t.Errorf("BaseAmount: expected %d, got %d", tt.wantBase, base)
+			}
+		})
+	}
+}
+
+func TestEngineCalculateOptions(t *testing.T) {
+	lighting, backdrop := uuid.New(), uuid.New()
+	options := []OptionLine{
+		{OptionID: lighting, Name: "照明セット", UnitPrice: 1100, Unit: "セット", Quantity: 2},
+		// 円未満は明細ごとに四捨五入する
+		{OptionID: backdrop, Name: "背景紙", UnitPrice: 333.3, Unit: "m", Quantity: 3},
+	}
+
+	breakdown, err := NewEngine(testConfig(RoundingFloor), nil, nil).Calculate(at(1, 10, 0), at(1, 13, 0), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(breakdown.Items) != 3 {
+		t.Fatalf("expected studio and two option items, got %+v", breakdown.Items)
+	}
+	for i, want := range []struct {
+		id     uuid.UUID
+		amount int
+	}{{lighting, 2200}, {backdrop, 1000}} {
+		item := breakdown.Items[i+1]
+		if item.Kind != models.PriceLineItemOption || item.OptionID == nil || *item.OptionID != want.id {
+			t.Errorf("item %d: expected option %s, got %+v", i+1, want.id, item)
+		}
+		if item.Amount != want.amount {
+			t.Errorf("item %d: expected amount %d, got %d", i+1, want.amount, item.Amount)
+		}
+	}
+
+	// オプションは基本料金に含めず、小計に含める
+	if breakdown.BaseAmount != 15000 || breakdown.Subtotal != 18200 {
+		t.Fatalf("expected base 15000 and subtotal 18200, got %d and %d", breakdown.BaseAmount, breakdown.Subtotal)
+	}
+	if breakdown.TaxAmount != 1820 || breakdown.Total != 20020 {
+		t.Fatalf("expected tax 1820 and total 20020, got %d and %d", breakdown.TaxAmount, breakdown.Total)
+	}
+}
@@ -0,0 +1,154 @@
+package pricing
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// RateBand は曜日・時間帯ごとの料金帯
+// 日をまたぐ料金帯（例: 22:00〜翌5:00）は2つの料金帯に分けて登録する
+type RateBand struct {
+	Name              string
+	WeekdayMask       uint8 // 適用曜日のビットマスク（1<<time.Sunday 〜 1<<time.Saturday）
+	AppliesToHolidays bool  // 祝日に適用するかどうか
+	StartMinute       int   // 開始（0時からの経過分）
+	EndMinute         int   // 終了（0時からの経過分、1440で24:00）
+	HourlyRate        int   // 1時間あたりの料金（円、税抜）
+	Priority          int   // 重なる料金帯がある場合は値の大きいものを優先する
+}
+
+// WeekdayMask 曜日の一覧からビットマスクを作成する
+func WeekdayMask(days ...time.Weekday) uint8 {
+	var mask uint8
+	for _, d := range days {
+		mask |= 1 << uint(d)
+	}
+	return mask
+}
+
+// covers 料金帯が指定日の指定時刻（0時からの経過分）に適用されるかどうか
+func (b RateBand) covers(weekday time.Weekday, holiday bool, minute int) bool {
+	dayMatch := b.WeekdayMask&(1<<uint(weekday)) != 0 || (b.AppliesToHolidays && holiday)
+	return dayMatch && minute >= b.StartMinute && minute < b.EndMinute
+}
+
+// RateSource は料金帯の取得元（料金プランテーブルなど）
+type RateSource interface {
+	RateBands() ([]RateBand, error)
+}
+
+// RateTable は料金帯の一覧と、どの料金帯にも該当しない時間の基本料金
+type RateTable struct {
+	Bands       []RateBand
+	DefaultName string
+	DefaultRate int
+	Location    *time.Location
+	// IsHoliday は祝日判定。nilの場合は祝日を考慮しない
+	IsHoliday func(date time.Time) bool
+}
+
+// Segment は料金帯ごとに分割した利用時間
+type Segment struct {
+	Name       string
+	Start      time.Time
+	End        time.Time
+	HourlyRate int
+}
+
+// Hours 区間の時間数
+func (s Segment) Hours() float64 {
+	return s.End.Sub(s.Start).Hours()
+}
+
+// Amount 区間の料金（円未満四捨五入）
+func (s Segment) Amount() int {
+	return int(math.Round(s.Hours() * float64(s.HourlyRate)))
+}
+
+// Segments 利用時間を料金帯の境界で分割する
+// 連続して同じ料金帯が続く区間は1つにまとめる
+func (t RateTable) Segments(startTime, endTime time.Time) []Segment {
+	loc := t.Location
+	if loc == nil {
+		loc = time.Local
+	}
+
+	var segments []Segment
+	cursor := startTime.In(loc)
+	end := endTime.In(loc)
+
+	for cursor.Before(end) {
+		dayStart := time.Date(cursor.Year(), cursor.Month(), cursor.Day(), 0, 0, 0, 0, loc)
+		dayEnd := dayStart.AddDate(0, 0, 1)
+		limit := dayEnd
+		if end.Before(limit) {
+			limit = end
+		}
+
+		holiday := t.IsHoliday != nil && t.IsHoliday(dayStart)
+
+		// この日の中での境界（料金帯の開始・終了時刻）を列挙する
+		boundaries := []time.Time{limit}
+		for _, band := range t.Bands {
+			for _, minute := range []int{band.StartMinute, band.EndMinute} {
+				b := time.Date(dayStart.Year(), dayStart.Month(), dayStart.Day(), 0, minute, 0, 0, loc)
+				if b.After(cursor) && b.Before(limit) {
+					boundaries = append(boundaries, b)
+				}
+			}
+		}
+		sort.Slice(boundaries, func(i, j int) bool { return boundaries[i].Before(boundaries[j]) })
+
+		for _, next := range boundaries {
+			if !next.After(cursor) {
+				continue
+			}
+			minute := cursor.Hour()*60 + cursor.Minute()
+			name, rate := t.rateAt(dayStart.Weekday(), holiday, minute)
+			segments = appendSegment(segments, Segment{Name: name, Start: cursor, End: next, HourlyRate: rate})
+			cursor = next
+		}
+	}
+
+	return segments
+}
+
+// Price 利用時間の料金（最低利用時間は考慮しない）
+func (t RateTable) Price(startTime, endTime time.Time) int {
+	total := 0
+	for _, s := range t.Segments(startTime, endTime) {
+		total += s.Amount()
+	}
+	return total
+}
+
+// rateAt 指定時刻に適用される料金帯の名前と料金
+func (t RateTable) rateAt(weekday time.Weekday, holiday bool, minute int) (string, int) {
+	var matched *RateBand
+	for i := range t.Bands {
+		band := &t.Bands[i]
+		if !band.covers(weekday, holiday, minute) {
+			continue
+		}
+		if matched == nil || band.Priority > matched.Priority {
+			matched = band
+		}
+	}
+	if matched == nil {
+		return t.DefaultName, t.DefaultRate
+	}
+	return matched.Name, matched.HourlyRate
+}
+
+// appendSegment 直前の区間と同じ料金帯で連続していれば結合する
+func appendSegment(segments []Segment, s Segment) []Segment {
+	if n := len(segments); n > 0 {
+		last := &segments[n-1]
+		if last.Name == s.Name && last.HourlyRate == s.HourlyRate && last.End.Equal(s.Start) {
+			last.End = s.End
+			return segments
+		}
+	}
+	return append(segments, s)
+}
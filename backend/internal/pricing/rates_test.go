@@ -0,0 +1,176 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zebraApp/internal/holiday"
+)
+
+// wantSegment は期待する区間（開始・終了は testLocation の日・時・分）
+type wantSegment struct {
+	name  string
+	start time.Time
+	end   time.Time
+	rate  int
+}
+
+func assertSegments(t *testing.T, got []Segment, want []wantSegment) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d segments, got %d: %+v", len(want), len(got), got)
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Name != w.name || !g.Start.Equal(w.start) || !g.End.Equal(w.end) || g.HourlyRate != w.rate {
+			t.Errorf("segment %d: expected %s %s-%s @%d, got %s %s-%s @%d", i,
+				w.name, w.start.Format("01-02 15:04"), w.end.Format("01-02 15:04"), w.rate,
+				g.Name, g.Start.In(testLocation).Format("01-02 15:04"), g.End.In(testLocation).Format("01-02 15:04"), g.HourlyRate)
+		}
+	}
+}
+
+func TestRateTableBandPriority(t *testing.T) {
+	weekdays := WeekdayMask(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+	table := RateTable{
+		DefaultName: "基本料金",
+		DefaultRate: 5000,
+		Location:    testLocation,
+		Bands: []RateBand{
+			{Name: "平日昼間", WeekdayMask: weekdays, StartMinute: 9 * 60, EndMinute: 18 * 60, HourlyRate: 6000},
+			{Name: "ピーク", WeekdayMask: weekdays, StartMinute: 12 * 60, EndMinute: 14 * 60, HourlyRate: 9000, Priority: 10},
+			// 同じ優先度では先に登録した料金帯を使う
+			{Name: "平日昼間（重複）", WeekdayMask: weekdays, StartMinute: 9 * 60, EndMinute: 18 * 60, HourlyRate: 1000},
+		},
+	}
+
+	// 2025-04-01は火曜日
+	segments := table.Segments(at(1, 8, 0), at(1, 19, 0))
+	assertSegments(t, segments, []wantSegment{
+		{"基本料金", at(1, 8, 0), at(1, 9, 0), 5000},
+		{"平日昼間", at(1, 9, 0), at(1, 12, 0), 6000},
+		{"ピーク", at(1, 12, 0), at(1, 14, 0), 9000},
+		{"平日昼間", at(1, 14, 0), at(1, 18, 0), 6000},
+		{"基本料金", at(1, 18, 0), at(1, 19, 0), 5000},
+	})
+
+	want := 5000 + 3*6000 + 2*9000 + 4*6000 + 5000
+	if got := table.Price(at(1, 8, 0), at(1, 19, 0)); got != want {
+		t.Fatalf("expected price %d, got %d", want, got)
+	}
+
+	// 土曜日にはどの料金帯も適用しない
+	assertSegments(t, table.Segments(at(5, 10, 0), at(5, 13, 0)), []wantSegment{
+		{"基本料金", at(5, 10, 0), at(5, 13, 0), 5000},
+	})
+}
+
+func TestRateTableSegmentsAcrossMidnight(t *testing.T) {
+	// 日をまたぐ深夜料金は2つの料金帯に分けて登録する（金曜22:00〜土曜5:00）
+	table := RateTable{
+		DefaultName: "基本料金",
+		DefaultRate: 5000,
+		Location:    testLocation,
+		Bands: []RateBand{
+			{Name: "深夜", WeekdayMask: WeekdayMask(time.Friday), StartMinute: 22 * 60, EndMinute: 24 * 60, HourlyRate: 7000},
+			{Name: "深夜", WeekdayMask: WeekdayMask(time.Saturday), StartMinute: 0, EndMinute: 5 * 60, HourlyRate: 7000},
+			{Name: "週末", WeekdayMask: WeekdayMask(time.Saturday), StartMinute: 5 * 60, EndMinute: 24 * 60, HourlyRate: 8000},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       []wantSegment
+	}{
+		{
+			// 同じ料金帯が日付をまたいで続く場合は1つの区間にまとめる
+			"continuous band is merged",
+			at(4, 21, 0), at(5, 2, 0),
+			[]wantSegment{
+				{"基本料金", at(4, 21, 0), at(4, 22, 0), 5000},
+				{"深夜", at(4, 22, 0), at(5, 2, 0), 7000},
+			},
+		},
+		{
+			"split at band end after midnight",
+			at(4, 23, 30), at(5, 6, 0),
+			[]wantSegment{
+				{"深夜", at(4, 23, 30), at(5, 5, 0), 7000},
+				{"週末", at(5, 5, 0), at(5, 6, 0), 8000},
+			},
+		},
+		{
+			// 料金帯のない日も0時で日付を切り替えて判定する
+			"default rate on both days",
+			at(2, 23, 0), at(3, 1, 0),
+			[]wantSegment{
+				{"基本料金", at(2, 23, 0), at(3, 1, 0), 5000},
+			},
+		},
+		{
+			// UTCで指定してもスタジオのタイムゾーンの0時で分割する
+			"utc input",
+			at(4, 23, 0).UTC(), at(5, 6, 0).UTC(),
+			[]wantSegment{
+				{"深夜", at(4, 23, 0), at(5, 5, 0), 7000},
+				{"週末", at(5, 5, 0), at(5, 6, 0), 8000},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertSegments(t, table.Segments(tt.start, tt.end), tt.want)
+		})
+	}
+}
+
+func TestRateTableSegmentsOnHolidays(t *testing.T) {
+	// 2025-04-29（火）は昭和の日
+	holidays := holiday.NewList([]holiday.Holiday{
+		{Date: time.Date(2025, 4, 29, 0, 0, 0, 0, time.UTC), Name: "昭和の日"},
+	})
+	weekdays := WeekdayMask(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+	table := RateTable{
+		DefaultName: "基本料金",
+		DefaultRate: 5000,
+		Location:    testLocation,
+		IsHoliday:   holiday.Checker(holidays),
+		Bands: []RateBand{
+			{Name: "平日夜間", WeekdayMask: weekdays, StartMinute: 18 * 60, EndMinute: 24 * 60, HourlyRate: 6000},
+			{Name: "土日祝", WeekdayMask: WeekdayMask(time.Saturday, time.Sunday), AppliesToHolidays: true, StartMinute: 0, EndMinute: 24 * 60, HourlyRate: 8000, Priority: 1},
+		},
+	}
+
+	// 平日の夜から祝日にまたがる利用は0時で分割する
+	assertSegments(t, table.Segments(at(28, 22, 0), at(29, 2, 0)), []wantSegment{
+		{"平日夜間", at(28, 22, 0), at(29, 0, 0), 6000},
+		{"土日祝", at(29, 0, 0), at(29, 2, 0), 8000},
+	})
+
+	// 祝日の夜は優先度の高い土日祝料金を使う
+	assertSegments(t, table.Segments(at(29, 17, 0), at(29, 20, 0)), []wantSegment{
+		{"土日祝", at(29, 17, 0), at(29, 20, 0), 8000},
+	})
+
+	// 祝日判定がない場合は平日として扱う
+	table.IsHoliday = nil
+	assertSegments(t, table.Segments(at(29, 17, 0), at(29, 20, 0)), []wantSegment{
+		{"基本料金", at(29, 17, 0), at(29, 18, 0), 5000},
+		{"平日夜間", at(29, 18, 0), at(29, 20, 0), 6000},
+	})
+}
+
+func TestSegmentAmountRounding(t *testing.T) {
+	// 1.5時間 × 3,333円 = 4,999.5円 → 四捨五入で5,000円
+	s := Segment{Start: at(1, 10, 0), End: at(1, 11, 30), HourlyRate: 3333}
+	if got := s.Amount(); got != 5000 {
+		t.Fatalf("expected 5000, got %d", got)
+	}
+	// 20分 × 5,000円 = 1,666.67円 → 1,667円
+	s = Segment{Start: at(1, 10, 0), End: at(1, 10, 20), HourlyRate: 5000}
+	if got := s.Amount(); got != 1667 {
+		t.Fatalf("expected 1667, got %d", got)
+	}
+}
@@ -0,0 +1,116 @@
+package schedule
+
+import "time"
+
+// dateLayout は特定日の営業時間を引くためのキーの形式
+const dateLayout = "2006-01-02"
+
+// DayHours は1日の営業時間
+type DayHours struct {
+	Closed      bool
+	OpenMinute  int // 0時からの経過分
+	CloseMinute int // 0時からの経過分（1440で24:00）
+}
+
+// BusinessCalendar は曜日ごとの営業時間と特定日・祝日の例外
+// 営業時間の優先順位は 特定日 > 祝日 > 曜日 で、設定のない曜日は休業日とする
+type BusinessCalendar struct {
+	Weekly   map[time.Weekday]DayHours
+	Dates    map[string]DayHours // キーは"2006-01-02"
+	Holiday  *DayHours           // 祝日の営業時間。nilの場合は曜日の営業時間に従う
+	Location *time.Location
+	// IsHoliday は祝日判定。nilの場合は祝日を考慮しない
+	IsHoliday func(date time.Time) bool
+}
+
+// DateKey 特定日の営業時間を引くためのキー
+func DateKey(date time.Time) string {
+	return date.Format(dateLayout)
+}
+
+// HoursOn 指定日（dateの年月日）の営業時間
+func (c BusinessCalendar) HoursOn(date time.Time) DayHours {
+	if hours, ok := c.Dates[DateKey(date)]; ok {
+		return hours
+	}
+	if c.Holiday != nil && c.IsHoliday != nil && c.IsHoliday(c.dayStart(date)) {
+		return *c.Holiday
+	}
+	if hours, ok := c.Weekly[date.Weekday()]; ok {
+		return hours
+	}
+	return DayHours{Closed: true}
+}
+
+// OpenPeriod 指定日（dateの年月日）の営業開始・終了時刻。休業日の場合はokがfalse
+func (c BusinessCalendar) OpenPeriod(date time.Time) (open, close time.Time, ok bool) {
+	hours := c.HoursOn(date)
+	if hours.Closed || hours.CloseMinute <= hours.OpenMinute {
+		return time.Time{}, time.Time{}, false
+	}
+
+	loc := c.location()
+	open = time.Date(date.Year(), date.Month(), date.Day(), 0, hours.OpenMinute, 0, 0, loc)
+	close = time.Date(date.Year(), date.Month(), date.Day(), 0, hours.CloseMinute, 0, 0, loc)
+	return open, close, true
+}
+
+// Contains 利用時間がすべて営業時間内かどうか
+// 24:00まで営業し翌日が0:00から営業する場合は、日をまたぐ利用も営業時間内とする
+func (c BusinessCalendar) Contains(startTime, endTime time.Time) bool {
+	loc := c.location()
+	cursor := startTime.In(loc)
+	end := endTime.In(loc)
+	if !cursor.Before(end) {
+		return false
+	}
+
+	for cursor.Before(end) {
+		open, close, ok := c.OpenPeriod(cursor)
+		if !ok || cursor.Before(open) || !cursor.Before(close) {
+			return false
+		}
+		cursor = close
+	}
+	return true
+}
+
+func (c BusinessCalendar) dayStart(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, c.location())
+}
+
+func (c BusinessCalendar) location() *time.Location {
+	if c.Location == nil {
+		return time.Local
+	}
+	return c.Location
+}
+
+// TimeOfDay は1日の中の時間帯（0時からの経過分）
+type TimeOfDay struct {
+	FromMinute int
+	ToMinute   int // 1440で24:00
+}
+
+// OpenIntervals fromの日からtoの前日までの営業時間を開始時刻順に返す
+// withinが指定された場合は各日のその時間帯に絞る。日をまたいで連続する営業時間は1つの区間にまとめる
+func (c BusinessCalendar) OpenIntervals(from, to time.Time, within *TimeOfDay) []Interval {
+	var intervals []Interval
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		open, close, ok := c.OpenPeriod(day)
+		if !ok {
+			continue
+		}
+		if within != nil {
+			dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, c.location())
+			if earliest := dayStart.Add(time.Duration(within.FromMinute) * time.Minute); earliest.After(open) {
+				open = earliest
+			}
+			if latest := dayStart.Add(time.Duration(within.ToMinute) * time.Minute); latest.Before(close) {
+				close = latest
+			}
+		}
+		intervals = append(intervals, Interval{Start: open, End: close})
+	}
+	return Merge(intervals)
+}
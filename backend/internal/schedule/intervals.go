@@ -0,0 +1,172 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// Interval は時間区間 [Start, End)
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration 区間の長さ
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// Merge 区間を開始時刻順に並べ、重なる・接する区間を1つにまとめる
+// 長さが0以下の区間は除く。引数のスライスは変更しない
+func Merge(intervals []Interval) []Interval {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, i := range intervals {
+		if i.End.After(i.Start) {
+			sorted = append(sorted, i)
+		}
+	}
+	sort.Slice(sorted, func(a, b int) bool { return sorted[a].Start.Before(sorted[b].Start) })
+
+	merged := sorted[:0]
+	for _, i := range sorted {
+		if n := len(merged); n > 0 && !i.Start.After(merged[n-1].End) {
+			if i.End.After(merged[n-1].End) {
+				merged[n-1].End = i.End
+			}
+			continue
+		}
+		merged = append(merged, i)
+	}
+	return merged
+}
+
+// Timeline は使用中の時間帯（結合済み・開始時刻順）
+type Timeline []Interval
+
+// NewTimeline 使用中の区間の一覧からTimelineを作成する
+func NewTimeline(busy []Interval) Timeline {
+	return Timeline(Merge(busy))
+}
+
+// Free 指定区間が使用中の時間帯と重ならないかどうか
+func (t Timeline) Free(start, end time.Time) bool {
+	i := sort.Search(len(t), func(i int) bool { return t[i].End.After(start) })
+	return i == len(t) || !t[i].Start.Before(end)
+}
+
+// AlternativeQuery は代替枠の検索条件
+type AlternativeQuery struct {
+	Start     time.Time     // 希望の開始時刻
+	Duration  time.Duration // 利用時間
+	Step      time.Duration // 候補をずらす刻み
+	Horizon   time.Duration // 希望の開始時刻から前後に探す範囲
+	NotBefore time.Time     // これより前に始まる候補は除く（ゼロ値は制限なし）
+	Limit     int           // 最大件数
+	// Accept は営業時間などの追加条件。nilの場合は空いていれば候補とする
+	Accept func(start, end time.Time) bool
+}
+
+// Alternatives 希望の開始時刻に近い順に、同じ長さの空き枠を返す
+// 前後で同じ距離の候補がある場合は後ろを先にする
+func (t Timeline) Alternatives(q AlternativeQuery) []Interval {
+	if q.Step <= 0 || q.Duration <= 0 || q.Limit <= 0 {
+		return nil
+	}
+
+	var windows []Interval
+	for offset := q.Step; offset <= q.Horizon; offset += q.Step {
+		for _, start := range []time.Time{q.Start.Add(offset), q.Start.Add(-offset)} {
+			end := start.Add(q.Duration)
+			if !q.NotBefore.IsZero() && start.Before(q.NotBefore) {
+				continue
+			}
+			if !t.Free(start, end) || (q.Accept != nil && !q.Accept(start, end)) {
+				continue
+			}
+			windows = append(windows, Interval{Start: start, End: end})
+			if len(windows) == q.Limit {
+				return windows
+			}
+		}
+	}
+	return windows
+}
+
+// FreeWithin 区間の一覧（開始時刻順・重なりなし）から使用中の時間帯を除いた空き区間を返す
+// 使用中の時間帯を1つのカーソルで走査するため、区間数と使用中の時間帯の数の和に比例する時間で終わる
+func (t Timeline) FreeWithin(windows []Interval) []Interval {
+	var free []Interval
+	next := 0
+	for _, w := range windows {
+		// この区間より前に終わる使用中の時間帯は以降の区間にも掛からない
+		for next < len(t) && !t[next].End.After(w.Start) {
+			next++
+		}
+
+		cursor := w.Start
+		for i := next; i < len(t) && t[i].Start.Before(w.End); i++ {
+			if t[i].Start.After(cursor) {
+				free = append(free, Interval{Start: cursor, End: t[i].Start})
+			}
+			if t[i].End.After(cursor) {
+				cursor = t[i].End
+			}
+		}
+		if cursor.Before(w.End) {
+			free = append(free, Interval{Start: cursor, End: w.End})
+		}
+	}
+	return free
+}
+
+// StartTimes 空き区間の中でdurationの枠が収まる開始時刻をすべて返す
+// 開始時刻はloc基準の0時からstep刻みに揃える
+func StartTimes(free []Interval, duration, step time.Duration, loc *time.Location) []time.Time {
+	if step <= 0 || duration <= 0 {
+		return nil
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+
+	var starts []time.Time
+	for _, f := range free {
+		if f.Duration() < duration {
+			continue
+		}
+		for start := alignUp(f.Start, step, loc); !start.Add(duration).After(f.End); start = start.Add(step) {
+			starts = append(starts, start)
+		}
+	}
+	return starts
+}
+
+// alignUp 時刻をloc基準の0時からstep刻みの時刻に切り上げる
+func alignUp(t time.Time, step time.Duration, loc *time.Location) time.Time {
+	local := t.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	if rem := local.Sub(midnight) % step; rem != 0 {
+		return t.Add(step - rem)
+	}
+	return t
+}
+
+// Cursor は開始時刻順に並んだ区間を、使用中の時間帯と先頭から順に突き合わせる
+type Cursor struct {
+	timeline Timeline
+	next     int
+}
+
+// Cursor 先頭から走査するCursorを作成する
+func (t Timeline) Cursor() *Cursor {
+	return &Cursor{timeline: t}
+}
+
+// Free 区間が使用中の時間帯と重ならないかどうか
+// 開始時刻が前回の問い合わせ以降の区間を順に渡す必要がある
+func (c *Cursor) Free(start, end time.Time) bool {
+	for c.next < len(c.timeline) && !c.timeline[c.next].End.After(start) {
+		c.next++
+	}
+	return c.next == len(c.timeline) || !c.timeline[c.next].Start.Before(end)
+}
@@ -0,0 +1,97 @@
+package schedule
+
+import "time"
+
+// Frequency は繰り返しの単位
+type Frequency string
+
+const (
+	// Daily は日単位の繰り返し
+	Daily Frequency = "daily"
+	// Weekly は週単位の繰り返し（初回と同じ曜日）
+	Weekly Frequency = "weekly"
+	// Monthly は月単位の繰り返し（初回と同じ日）
+	Monthly Frequency = "monthly"
+)
+
+// Valid は定義済みの繰り返しの単位かどうか
+func (f Frequency) Valid() bool {
+	switch f {
+	case Daily, Weekly, Monthly:
+		return true
+	}
+	return false
+}
+
+// Recurrence はRRULE（RFC 5545）に倣った繰り返しの規則
+// CountとUntilの両方を省略した場合は、Occurrencesのlimitで打ち切る
+type Recurrence struct {
+	Frequency Frequency
+	// Interval は繰り返しの間隔（2で隔週・隔月）。1未満は1として扱う
+	Interval int
+	// Count は繰り返しの回数（0は指定なし）。除外日もCountに含めて数える
+	Count int
+	// Until はこの日までに始まる回を含める（ゼロ値は指定なし）
+	Until time.Time
+	// Exceptions は除外する日付
+	Exceptions []time.Time
+}
+
+// Occurrences 初回の開始時刻firstから、規則に従う各回の開始時刻を順に返す（最大limit件）
+// 日付はlocの暦で数え、各回の時刻はfirstと同じ壁時計の時刻とする。
+// 月単位で同じ日が存在しない月（31日に対する30日までの月など）は飛ばす
+func (r Recurrence) Occurrences(first time.Time, loc *time.Location, limit int) []time.Time {
+	if !r.Frequency.Valid() || limit <= 0 {
+		return nil
+	}
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	local := first.In(loc)
+	year, month, day := local.Date()
+	hour, min, sec := local.Clock()
+	nsec := local.Nanosecond()
+
+	var untilEnd time.Time
+	if !r.Until.IsZero() {
+		y, m, d := r.Until.In(loc).Date()
+		untilEnd = time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+	}
+
+	excluded := make(map[string]bool, len(r.Exceptions))
+	for _, e := range r.Exceptions {
+		excluded[e.In(loc).Format("2006-01-02")] = true
+	}
+
+	var occurrences []time.Time
+	generated := 0
+	for n := 0; len(occurrences) < limit; n++ {
+		var start time.Time
+		switch r.Frequency {
+		case Daily:
+			start = time.Date(year, month, day+n*interval, hour, min, sec, nsec, loc)
+		case Weekly:
+			start = time.Date(year, month, day+7*n*interval, hour, min, sec, nsec, loc)
+		case Monthly:
+			start = time.Date(year, month+time.Month(n*interval), day, hour, min, sec, nsec, loc)
+			if start.Day() != day {
+				continue
+			}
+		}
+
+		if !untilEnd.IsZero() && !start.Before(untilEnd) {
+			break
+		}
+		generated++
+		if r.Count > 0 && generated > r.Count {
+			break
+		}
+		if excluded[start.Format("2006-01-02")] {
+			continue
+		}
+		occurrences = append(occurrences, start)
+	}
+	return occurrences
+}
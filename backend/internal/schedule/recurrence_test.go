@@ -0,0 +1,101 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, jst)
+	}
+	// 初回は2025/01/06（月）10:00
+	first := time.Date(2025, 1, 6, 10, 0, 0, 0, jst)
+
+	tests := []struct {
+		name  string
+		rule  Recurrence
+		first time.Time
+		limit int
+		want  []string
+	}{
+		{
+			name:  "weekly count",
+			rule:  Recurrence{Frequency: Weekly, Count: 3},
+			first: first,
+			limit: 10,
+			want:  []string{"2025-01-06 10:00", "2025-01-13 10:00", "2025-01-20 10:00"},
+		},
+		{
+			name:  "biweekly until is inclusive",
+			rule:  Recurrence{Frequency: Weekly, Interval: 2, Until: date(2025, 2, 3)},
+			first: first,
+			limit: 10,
+			want:  []string{"2025-01-06 10:00", "2025-01-20 10:00", "2025-02-03 10:00"},
+		},
+		{
+			name:  "exceptions count towards count",
+			rule:  Recurrence{Frequency: Weekly, Count: 3, Exceptions: []time.Time{date(2025, 1, 13)}},
+			first: first,
+			limit: 10,
+			want:  []string{"2025-01-06 10:00", "2025-01-20 10:00"},
+		},
+		{
+			name:  "daily interval",
+			rule:  Recurrence{Frequency: Daily, Interval: 3, Count: 3},
+			first: first,
+			limit: 10,
+			want:  []string{"2025-01-06 10:00", "2025-01-09 10:00", "2025-01-12 10:00"},
+		},
+		{
+			name:  "monthly skips months without the day",
+			rule:  Recurrence{Frequency: Monthly, Count: 4},
+			first: time.Date(2025, 1, 31, 18, 30, 0, 0, jst),
+			limit: 10,
+			want:  []string{"2025-01-31 18:30", "2025-03-31 18:30", "2025-05-31 18:30", "2025-07-31 18:30"},
+		},
+		{
+			name:  "month boundary crosses year",
+			rule:  Recurrence{Frequency: Monthly, Interval: 2, Until: date(2026, 3, 1)},
+			first: time.Date(2025, 11, 15, 9, 0, 0, 0, jst),
+			limit: 10,
+			want:  []string{"2025-11-15 09:00", "2026-01-15 09:00"},
+		},
+		{
+			name:  "limit without end",
+			rule:  Recurrence{Frequency: Weekly},
+			first: first,
+			limit: 2,
+			want:  []string{"2025-01-06 10:00", "2025-01-13 10:00"},
+		},
+		{
+			name:  "dates are counted in the given location",
+			rule:  Recurrence{Frequency: Weekly, Count: 2},
+			first: time.Date(2025, 1, 5, 16, 0, 0, 0, time.UTC), // 2025/01/06 01:00 JST
+			limit: 10,
+			want:  []string{"2025-01-06 01:00", "2025-01-13 01:00"},
+		},
+		{
+			name:  "unknown frequency",
+			rule:  Recurrence{Frequency: "yearly", Count: 3},
+			first: first,
+			limit: 10,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule.Occurrences(tt.first, jst, tt.limit)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d occurrences, got %d: %v", len(tt.want), len(got), got)
+			}
+			for i, start := range got {
+				if s := start.In(jst).Format("2006-01-02 15:04"); s != tt.want[i] {
+					t.Errorf("occurrence %d: expected %s, got %s", i, tt.want[i], s)
+				}
+			}
+		})
+	}
+}
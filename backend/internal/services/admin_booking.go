@@ -5,40 +5,54 @@ import (
 	"fmt"
 	"time"
 
-	"your-project/internal/models"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/pricing"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type AdminBookingServiceImpl struct {
-	db *gorm.DB
+	db                 *gorm.DB
+	keepQueue          *KeepQueueServiceImpl
+	states             *BookingStateMachine
+	cancellationPolicy CancellationPolicy
+	pricing            *pricing.Engine
+	validator          *BookingValidatorImpl
+	// location は検索条件の日付を解釈するスタジオのタイムゾーン
+	location *time.Location
 }
 
-func NewAdminBookingService(db *gorm.DB) *AdminBookingServiceImpl {
-	return &AdminBookingServiceImpl{db: db}
+func NewAdminBookingService(db *gorm.DB, cancellationPolicy CancellationPolicy, pricingEngine *pricing.Engine, validator *BookingValidatorImpl, loc *time.Location) *AdminBookingServiceImpl {
+	if loc == nil {
+		loc = time.Local
+	}
+
+	return &AdminBookingServiceImpl{
+		db:                 db,
+		keepQueue:          NewKeepQueueService(db),
+		states:             NewBookingStateMachine(db, cancellationPolicy, pricingEngine),
+		cancellationPolicy: cancellationPolicy,
+		pricing:            pricingEngine,
+		validator:          validator,
+		location:           loc,
+	}
 }
 
 // CreateBooking 管理者による予約作成
 func (s *AdminBookingServiceImpl) CreateBooking(req CreateBookingRequest, adminID string) (*BookingResponse, error) {
 	// ユーザーの存在確認
+	if _, err := uuid.Parse(req.UserID); err != nil {
+		return nil, ErrUserNotFound
+	}
 	var user models.User
 	if err := s.db.First(&user, "id = ?", req.UserID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("指定されたユーザーが見つかりません")
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("ユーザーの確認に失敗しました: %w", err)
 	}
 
-	// 時間の競合チェック
-	available, err := s.CheckAvailability(req.StartTime, req.EndTime, "")
-	if err != nil {
-		return nil, fmt.Errorf("空き状況の確認に失敗しました: %w", err)
-	}
-	if !available {
-		return nil, fmt.Errorf("選択された時間帯には既に予約があります")
-	}
-
 	// ステータスのデフォルト設定
 	status := models.BookingStatusPending
 	if req.Status != "" {
@@ -55,7 +69,7 @@ func (s *AdminBookingServiceImpl) CreateBooking(req CreateBookingRequest, adminI
 	// 予約の作成
 	booking := models.Booking{
 		ID:          bookingID,
-		UserID:      user.ID,
+		UserID:      &user.ID,
 		StartTime:   req.StartTime,
 		EndTime:     req.EndTime,
 		Status:      status,
@@ -66,6 +80,14 @@ func (s *AdminBookingServiceImpl) CreateBooking(req CreateBookingRequest, adminI
 		UpdatedAt:   time.Now(),
 	}
 
+	// 仮予約には利用者による申請と同じ確認期限を設定する
+	setConfirmationDeadline(&booking, booking.CreatedAt)
+
+	// ステータス・予約タイプの検証
+	if err := s.states.Check(models.Booking{}, booking.State(), BookingActorAdmin, time.Now()); err != nil {
+		return nil, err
+	}
+
 	// トランザクション開始
 	tx := s.db.Begin()
 	defer func() {
@@ -74,48 +96,34 @@ func (s *AdminBookingServiceImpl) CreateBooking(req CreateBookingRequest, adminI
 		}
 	}()
 
-	// 予約の保存
-	if err := tx.Create(&booking).Error; err != nil {
+	// 業務ルールの検証（管理者が明示的に省略を指定した場合は時間の前後関係のみ）
+	// 仮予約数は同時の申請と競合しないよう作成と同じトランザクションで数える
+	if err := s.validator.ValidateAdminBooking(tx, booking, time.Now(), req.OverrideRules); err != nil {
 		tx.Rollback()
-		return nil, fmt.Errorf("予約の作成に失敗しました: %w", err)
+		return nil, err
 	}
 
-	// オプションの追加
-	if len(req.OptionIDs) > 0 {
-		for _, optionIDStr := range req.OptionIDs {
-			optionID, err := uuid.Parse(optionIDStr)
-			if err != nil {
-				tx.Rollback()
-				return nil, fmt.Errorf("無効なオプションIDです: %s", optionIDStr)
-			}
-
-			bookingOption := models.BookingOption{
-				ID:        uuid.New(),
-				BookingID: bookingID,
-				OptionID:  optionID,
-			}
-
-			if err := tx.Create(&bookingOption).Error; err != nil {
-				tx.Rollback()
-				return nil, fmt.Errorf("オプションの追加に失敗しました: %w", err)
-			}
+	// ステータスの検証・キープ順序の決定・保存（同時実行時の最終的な保証はデータベースの排他制約で行う）
+	if err := s.states.Create(tx, &booking, BookingActorAdmin, adminBookingNote("管理者による予約作成", req.OverrideRules)); err != nil {
+		tx.Rollback()
+		if isBookingOverlapViolation(err) {
+			return nil, newBookingConflictError(s.db, req.StartTime, req.EndTime, "")
 		}
+		return nil, err
 	}
 
-	// ステータスログの記録
-	statusLog := models.BookingStatusLog{
-		ID:             uuid.New(),
-		BookingID:      bookingID,
-		PreviousStatus: "", // 新規作成
-		NewStatus:      status,
-		ChangedBy:      adminUUID,
-		ChangedAt:      time.Now(),
-		Reason:         "管理者による予約作成",
+	// オプションの追加
+	for _, optionIDStr := range req.OptionIDs {
+		if err := createBookingOption(tx, bookingID, SelectedOptionRequest{OptionID: optionIDStr, Quantity: 1}); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
 	}
 
-	if err := tx.Create(&statusLog).Error; err != nil {
+	// 料金内訳のスナップショット
+	if err := snapshotBookingPrice(tx, s.pricing, bookingID); err != nil {
 		tx.Rollback()
-		return nil, fmt.Errorf("ステータスログの記録に失敗しました: %w", err)
+		return nil, err
 	}
 
 	// コミット
@@ -127,97 +135,140 @@ func (s *AdminBookingServiceImpl) CreateBooking(req CreateBookingRequest, adminI
 	return s.GetBookingByID(bookingID.String())
 }
 
+// adminBookingNote 業務ルールの検証を省略した場合はステータスログにその旨を残す
+func adminBookingNote(note string, overridden bool) string {
+	if overridden {
+		return note + "（業務ルールの検証を省略）"
+	}
+	return note
+}
+
 // UpdateBooking 予約情報の更新
 func (s *AdminBookingServiceImpl) UpdateBooking(bookingID string, req UpdateBookingRequest, adminID string) (*BookingResponse, error) {
+	// 管理者UUIDの変換
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("管理者IDが無効です: %w", err)
+	}
+
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		return s.updateBooking(tx, bookingID, req, adminUUID)
+	}); err != nil {
+		return nil, err
+	}
+
+	// 更新された予約を取得して返却
+	return s.GetBookingByID(bookingID)
+}
+
+// updateBooking 予約1件を更新する
+// 呼び出し元のトランザクション内で実行し、予約の行をロックしてから状態遷移・オプション・料金を更新する
+func (s *AdminBookingServiceImpl) updateBooking(tx *gorm.DB, bookingID string, req UpdateBookingRequest, adminUUID uuid.UUID) error {
 	// 予約の存在確認
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return ErrBookingNotFound
+	}
 	var booking models.Booking
-	if err := s.db.Preload("User").First(&booking, "id = ?", bookingID).Error; err != nil {
+	if err := tx.First(&booking, "id = ?", bookingID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("指定された予約が見つかりません")
+			return ErrBookingNotFound
 		}
-		return nil, fmt.Errorf("予約の確認に失敗しました: %w", err)
+		return fmt.Errorf("予約の確認に失敗しました: %w", err)
 	}
 
-	// 管理者UUIDの変換
-	adminUUID, err := uuid.Parse(adminID)
-	if err != nil {
-		return nil, fmt.Errorf("管理者IDが無効です: %w", err)
+	// 更新後の時間帯とステータス
+	newStartTime, newEndTime := booking.StartTime, booking.EndTime
+	if req.StartTime != nil {
+		newStartTime = *req.StartTime
+	}
+	if req.EndTime != nil {
+		newEndTime = *req.EndTime
+	}
+	newStatus := booking.Status
+	if req.Status != nil {
+		newStatus = models.BookingStatus(*req.Status)
+	}
+	newBookingType := booking.BookingType
+	if req.BookingType != nil {
+		newBookingType = models.BookingType(*req.BookingType)
 	}
 
-	// 時間の更新がある場合の競合チェック
-	if req.StartTime != nil && req.EndTime != nil {
-		available, err := s.CheckAvailability(*req.StartTime, *req.EndTime, bookingID)
-		if err != nil {
-			return nil, fmt.Errorf("空き状況の確認に失敗しました: %w", err)
-		}
-		if !available {
-			return nil, fmt.Errorf("選択された時間帯には既に他の予約があります")
-		}
+	// ステータス・予約タイプの変更可否を検証する
+	// 現在と同じステータスの指定は変更なしとして扱い、項目のみを更新する
+	target := models.BookingState{Type: newBookingType}
+	if newStatus != booking.Status {
+		target.Status = newStatus
+	}
+	if err := s.states.Check(booking, target, BookingActorAdmin, time.Now()); err != nil {
+		return err
 	}
 
-	// トランザクション開始
-	tx := s.db.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
+	willBeActive := isActiveStatus(newStatus)
+	timeChanged := !newStartTime.Equal(booking.StartTime) || !newEndTime.Equal(booking.EndTime)
 
-	// 変更前の状態を保存
-	originalStatus := booking.Status
+	// 時間を変更する場合は変更後の内容で業務ルールを検証する（管理者が明示的に省略を指定した場合は時間の前後関係のみ）
+	if timeChanged {
+		candidate := booking
+		candidate.StartTime, candidate.EndTime = newStartTime, newEndTime
+		candidate.BookingType = newBookingType
+		if err := s.validator.ValidateAdminBooking(tx, candidate, time.Now(), req.OverrideRules); err != nil {
+			return err
+		}
+	}
 
-	// 更新フィールドの設定
-	updates := map[string]interface{}{
-		"updated_at": time.Now(),
-		"updated_by": adminUUID,
+	// 他の操作と競合しないよう予約の行をロックする（状態遷移の可否はロック後に改めて検証される）
+	locked, err := lockBooking(tx, bookingID)
+	if err != nil {
+		return err
 	}
 
+	// 状態以外の更新フィールドの設定
+	updates := map[string]interface{}{}
+
 	if req.StartTime != nil {
 		updates["start_time"] = *req.StartTime
 	}
 	if req.EndTime != nil {
 		updates["end_time"] = *req.EndTime
 	}
-	if req.BookingType != nil {
-		updates["booking_type"] = *req.BookingType
-	}
 	if req.Purpose != nil {
 		updates["purpose"] = *req.Purpose
 	}
-	if req.Status != nil {
-		updates["status"] = *req.Status
+	// 仮予約の確認期限は利用開始時刻を超えない
+	if booking.ConfirmationDeadline != nil && booking.ConfirmationDeadline.After(newStartTime) {
+		updates["confirmation_deadline"] = newStartTime
 	}
 
-	// 予約の更新
-	if err := tx.Model(&booking).Updates(updates).Error; err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("予約の更新に失敗しました: %w", err)
-	}
-
-	// ステータスが変更された場合のログ記録
-	if req.Status != nil && models.BookingStatus(*req.Status) != originalStatus {
-		statusLog := models.BookingStatusLog{
-			ID:             uuid.New(),
-			BookingID:      booking.ID,
-			PreviousStatus: originalStatus,
-			NewStatus:      models.BookingStatus(*req.Status),
-			ChangedBy:      adminUUID,
-			ChangedAt:      time.Now(),
-			Reason:         "管理者による予約更新",
+	// 時間帯を変更する場合は変更後の時間帯でキープ順序を決め直す
+	if willBeActive && timeChanged {
+		keepOrder, err := s.keepQueue.AssignKeepOrder(tx, newStartTime, newEndTime, newBookingType, bookingID)
+		if err != nil {
+			return err
 		}
-
-		if err := tx.Create(&statusLog).Error; err != nil {
-			tx.Rollback()
-			return nil, fmt.Errorf("ステータスログの記録に失敗しました: %w", err)
+		updates["keep_order"] = keepOrder
+	}
+
+	// 予約の更新（キャンセル料の記録・キープの繰り上げ・ステータスログは状態遷移のフックで行う）
+	if _, err := s.states.Transition(tx, BookingTransition{
+		Booking:     locked,
+		To:          target,
+		Actor:       BookingActorAdmin,
+		ChangedBy:   &adminUUID,
+		Note:        adminBookingNote("管理者による予約更新", req.OverrideRules && timeChanged),
+		Updates:     updates,
+		SlotChanged: timeChanged,
+	}); err != nil {
+		if isBookingOverlapViolation(err) {
+			return newBookingConflictError(s.db, newStartTime, newEndTime, bookingID)
 		}
+		return err
 	}
 
 	// オプションの更新
 	if req.OptionIDs != nil {
 		// 既存のオプションを削除
 		if err := tx.Where("booking_id = ?", booking.ID).Delete(&models.BookingOption{}).Error; err != nil {
-			tx.Rollback()
-			return nil, fmt.Errorf("既存オプションの削除に失敗しました: %w", err)
+			return fmt.Errorf("既存オプションの削除に失敗しました: %w", err)
 		}
 
 		// 新しいオプションを追加
@@ -226,32 +277,14 @@ func (s *AdminBookingServiceImpl) UpdateBooking(bookingID string, req UpdateBook
 				continue
 			}
 
-			optionID, err := uuid.Parse(optionIDStr)
-			if err != nil {
-				tx.Rollback()
-				return nil, fmt.Errorf("無効なオプションIDです: %s", optionIDStr)
-			}
-
-			bookingOption := models.BookingOption{
-				ID:        uuid.New(),
-				BookingID: booking.ID,
-				OptionID:  optionID,
-			}
-
-			if err := tx.Create(&bookingOption).Error; err != nil {
-				tx.Rollback()
-				return nil, fmt.Errorf("オプションの追加に失敗しました: %w", err)
+			if err := createBookingOption(tx, booking.ID, SelectedOptionRequest{OptionID: optionIDStr, Quantity: 1}); err != nil {
+				return err
 			}
 		}
 	}
 
-	// コミット
-	if err := tx.Commit().Error; err != nil {
-		return nil, fmt.Errorf("トランザクションのコミットに失敗しました: %w", err)
-	}
-
-	// 更新された予約を取得して返却
-	return s.GetBookingByID(bookingID)
+	// 時間帯・オプションの変更を反映して料金を再計算
+	return snapshotBookingPrice(tx, s.pricing, booking.ID)
 }
 
 // GetBookings 予約一覧取得
@@ -266,7 +299,7 @@ func (s *AdminBookingServiceImpl) GetBookings(filters BookingFilters, page, limi
 		if filters.Status == "expiring" {
 			// 期限切れ間近（48時間以内）の仮予約
 			deadline := time.Now().Add(48 * time.Hour)
-			query = query.Where("booking_type = ? AND status = ? AND deadline_at <= ? AND deadline_at > ?",
+			query = query.Where("booking_type = ? AND status = ? AND confirmation_deadline <= ? AND confirmation_deadline > ?",
 				models.BookingTypeTemporary, models.BookingStatusPending, deadline, time.Now())
 		} else {
 			query = query.Where("status = ?", filters.Status)
@@ -282,16 +315,16 @@ func (s *AdminBookingServiceImpl) GetBookings(filters BookingFilters, page, limi
 	}
 
 	if filters.StartDate != "" {
-		startDate, err := time.Parse("2006-01-02", filters.StartDate)
+		startDate, err := time.ParseInLocation("2006-01-02", filters.StartDate, s.location)
 		if err == nil {
 			query = query.Where("start_time >= ?", startDate)
 		}
 	}
 
 	if filters.EndDate != "" {
-		endDate, err := time.Parse("2006-01-02", filters.EndDate)
+		endDate, err := time.ParseInLocation("2006-01-02", filters.EndDate, s.location)
 		if err == nil {
-			endDate = endDate.Add(24 * time.Hour) // 当日の終わりまで
+			endDate = endDate.AddDate(0, 0, 1) // 当日の終わりまで
 			query = query.Where("start_time < ?", endDate)
 		}
 	}
@@ -329,7 +362,7 @@ func (s *AdminBookingServiceImpl) GetBookings(filters BookingFilters, page, limi
 	// レスポンス形式に変換
 	bookingResponses := make([]BookingResponse, len(bookings))
 	for i, booking := range bookings {
-		bookingResponses[i] = s.convertToBookingResponse(booking)
+		bookingResponses[i] = convertToBookingResponse(booking)
 	}
 
 	return &BookingListResponse{
@@ -343,32 +376,26 @@ func (s *AdminBookingServiceImpl) GetBookings(filters BookingFilters, page, limi
 
 // GetBookingByID 予約詳細取得
 func (s *AdminBookingServiceImpl) GetBookingByID(bookingID string) (*BookingResponse, error) {
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return nil, ErrBookingNotFound
+	}
 	var booking models.Booking
 	if err := s.db.Preload("User").
 		Preload("BookingOptions").
 		Preload("BookingOptions.Option").
 		First(&booking, "id = ?", bookingID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("指定された予約が見つかりません")
+			return nil, ErrBookingNotFound
 		}
 		return nil, fmt.Errorf("予約の取得に失敗しました: %w", err)
 	}
 
-	response := s.convertToBookingResponse(booking)
+	response := convertToBookingResponse(booking)
 	return &response, nil
 }
 
 // DeleteBooking 予約削除（論理削除）
 func (s *AdminBookingServiceImpl) DeleteBooking(bookingID string, adminID string) error {
-	// 予約の存在確認
-	var booking models.Booking
-	if err := s.db.First(&booking, "id = ?", bookingID).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("指定された予約が見つかりません")
-		}
-		return fmt.Errorf("予約の確認に失敗しました: %w", err)
-	}
-
 	// 管理者UUIDの変換
 	adminUUID, err := uuid.Parse(adminID)
 	if err != nil {
@@ -383,32 +410,24 @@ func (s *AdminBookingServiceImpl) DeleteBooking(bookingID string, adminID string
 		}
 	}()
 
-	// ステータスをキャンセルに変更（論理削除）
-	updates := map[string]interface{}{
-		"status":     models.BookingStatusCancelled,
-		"updated_at": time.Now(),
-		"updated_by": adminUUID,
-	}
-
-	if err := tx.Model(&booking).Updates(updates).Error; err != nil {
+	// 予約の存在確認
+	booking, err := lockBooking(tx, bookingID)
+	if err != nil {
 		tx.Rollback()
-		return fmt.Errorf("予約のキャンセルに失敗しました: %w", err)
+		return err
 	}
 
-	// ステータスログの記録
-	statusLog := models.BookingStatusLog{
-		ID:             uuid.New(),
-		BookingID:      booking.ID,
-		PreviousStatus: booking.Status,
-		NewStatus:      models.BookingStatusCancelled,
-		ChangedBy:      adminUUID,
-		ChangedAt:      time.Now(),
-		Reason:         "管理者による予約削除",
-	}
-
-	if err := tx.Create(&statusLog).Error; err != nil {
+	// ステータスをキャンセルに変更（論理削除）
+	// キャンセル料の記録とキープの繰り上げは状態遷移のフックで行う
+	if _, err := s.states.Transition(tx, BookingTransition{
+		Booking:   booking,
+		To:        models.BookingState{Status: models.BookingStatusCancelled},
+		Actor:     BookingActorAdmin,
+		ChangedBy: &adminUUID,
+		Note:      "管理者による予約削除",
+	}); err != nil {
 		tx.Rollback()
-		return fmt.Errorf("ステータスログの記録に失敗しました: %w", err)
+		return err
 	}
 
 	// コミット
@@ -419,6 +438,30 @@ func (s *AdminBookingServiceImpl) DeleteBooking(bookingID string, adminID string
 	return nil
 }
 
+// QuoteCancellation キャンセル料の見積もり
+func (s *AdminBookingServiceImpl) QuoteCancellation(bookingID string) (*CancellationQuote, error) {
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return nil, ErrBookingNotFound
+	}
+	var booking models.Booking
+	if err := s.db.First(&booking, "id = ?", bookingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBookingNotFound
+		}
+		return nil, fmt.Errorf("予約の確認に失敗しました: %w", err)
+	}
+
+	if !isActiveStatus(booking.Status) {
+		return nil, ErrBookingNotCancellable
+	}
+
+	quote, err := quoteCancellation(s.cancellationPolicy, s.pricing, booking, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &quote, nil
+}
+
 // SearchUsers ユーザー検索
 func (s *AdminBookingServiceImpl) SearchUsers(query string, limit int) ([]UserSearchResult, error) {
 	var users []models.User
@@ -440,43 +483,31 @@ func (s *AdminBookingServiceImpl) SearchUsers(query string, limit int) ([]UserSe
 	return results, nil
 }
 
-// CheckAvailability 空き状況確認
-func (s *AdminBookingServiceImpl) CheckAvailability(startTime, endTime time.Time, excludeBookingID string) (bool, error) {
-	query := s.db.Model(&models.Booking{}).
-		Where("start_time < ? AND end_time > ? AND status IN ?",
-			endTime, startTime, []models.BookingStatus{
-				models.BookingStatusPending,
-				models.BookingStatusApproved,
-			})
-
-	// 特定の予約を除外（編集時）
-	if excludeBookingID != "" {
-		query = query.Where("id != ?", excludeBookingID)
-	}
-
-	var count int64
-	if err := query.Count(&count).Error; err != nil {
-		return false, fmt.Errorf("空き状況の確認に失敗しました: %w", err)
-	}
-
-	return count == 0, nil
-}
-
 // convertToBookingResponse モデルをレスポンス形式に変換
-func (s *AdminBookingServiceImpl) convertToBookingResponse(booking models.Booking) BookingResponse {
+func convertToBookingResponse(booking models.Booking) BookingResponse {
 	response := BookingResponse{
 		ID:                      booking.ID.String(),
-		UserID:                  booking.UserID.String(),
 		StartTime:               booking.StartTime,
 		EndTime:                 booking.EndTime,
 		Status:                  string(booking.Status),
 		BookingType:             string(booking.BookingType),
+		KeepOrder:               booking.KeepOrder,
 		Purpose:                 booking.Purpose,
+		CancellationFeePercent:  booking.CancellationFeePercent,
+		CancellationFeeAmount:   booking.CancellationFeeAmount,
 		TotalAmountIncludingTax: booking.TotalAmountIncludingTax,
+		SubtotalAmount:          booking.SubtotalAmount,
+		TaxAmount:               booking.TaxAmount,
+		PriceBreakdown:          booking.PriceBreakdown,
 		CreatedAt:               booking.CreatedAt,
 		UpdatedAt:               booking.UpdatedAt,
 	}
 
+	// ユーザーが削除されている場合はUserIDがnilになる
+	if booking.UserID != nil {
+		response.UserID = booking.UserID.String()
+	}
+
 	// ユーザー情報
 	if booking.User != nil {
 		response.UserName = booking.User.FullName
@@ -491,16 +522,31 @@ func (s *AdminBookingServiceImpl) convertToBookingResponse(booking models.Bookin
 		response.UpdatedBy = booking.UpdatedBy.String()
 	}
 
+	// 承認者
+	if booking.ApprovedBy != nil {
+		response.ApprovedBy = booking.ApprovedBy.String()
+		response.ApprovedAt = booking.ApprovedAt
+	}
+
+	// 定期予約の回
+	if booking.SeriesID != nil {
+		response.SeriesID = booking.SeriesID.String()
+	}
+	if booking.OccurrenceDate != nil {
+		response.OccurrenceDate = booking.OccurrenceDate.Format(seriesDateLayout)
+	}
+
 	// オプション情報
 	if len(booking.BookingOptions) > 0 {
 		options := make([]BookingOptionResponse, len(booking.BookingOptions))
 		for i, bo := range booking.BookingOptions {
 			option := BookingOptionResponse{
-				ID: bo.OptionID.String(),
+				ID:       bo.OptionID.String(),
+				Price:    int(bo.Price),
+				Quantity: int(bo.Quantity),
 			}
 			if bo.Option != nil {
 				option.Name = bo.Option.Name
-				option.Price = bo.Option.Price
 			}
 			options[i] = option
 		}
@@ -510,7 +556,7 @@ func (s *AdminBookingServiceImpl) convertToBookingResponse(booking models.Bookin
 	return response
 }
 
-// インターフェースに必要な型定義をここで重複定義
+// コントローラーと共有するリクエスト・レスポンス型
 type CreateBookingRequest struct {
 	UserID      string    `json:"userId" validate:"required"`
 	UserEmail   string    `json:"userEmail,omitempty"`
@@ -521,7 +567,9 @@ type CreateBookingRequest struct {
 	Purpose     string    `json:"purpose,omitempty"`
 	Notes       string    `json:"notes,omitempty"`
 	OptionIDs   []string  `json:"optionIds,omitempty"`
-	Status      string    `json:"status,omitempty"`
+	Status      string    `json:"status,omitempty"` // 管理者が作成時にステータスを指定可能
+	// OverrideRules は最低利用時間・営業時間などの業務ルールの検証を省略する
+	OverrideRules bool `json:"overrideRules,omitempty"`
 }
 
 type UpdateBookingRequest struct {
@@ -532,6 +580,8 @@ type UpdateBookingRequest struct {
 	Notes       *string    `json:"notes,omitempty"`
 	Status      *string    `json:"status,omitempty"`
 	OptionIDs   []string   `json:"optionIds,omitempty"`
+	// OverrideRules は時間変更時の業務ルールの検証を省略する
+	OverrideRules bool `json:"overrideRules,omitempty"`
 }
 
 type BookingResponse struct {
@@ -543,13 +593,23 @@ type BookingResponse struct {
 	EndTime                 time.Time               `json:"endTime"`
 	Status                  string                  `json:"status"`
 	BookingType             string                  `json:"bookingType"`
+	KeepOrder               int                     `json:"keepOrder"`
 	Purpose                 string                  `json:"purpose"`
 	Notes                   string                  `json:"notes,omitempty"`
+	SubtotalAmount          int                     `json:"subtotalAmount"`
+	TaxAmount               int                     `json:"taxAmount"`
 	TotalAmountIncludingTax int                     `json:"totalAmountIncludingTax"`
+	PriceBreakdown          *models.PriceBreakdown  `json:"priceBreakdown,omitempty"`
+	CancellationFeePercent  float64                 `json:"cancellationFeePercent,omitempty"`
+	CancellationFeeAmount   int                     `json:"cancellationFeeAmount,omitempty"`
 	CreatedAt               time.Time               `json:"createdAt"`
 	UpdatedAt               time.Time               `json:"updatedAt"`
-	CreatedBy               string                  `json:"createdBy,omitempty"`
+	CreatedBy               string                  `json:"createdBy,omitempty"` // 管理者が作成した場合
 	UpdatedBy               string                  `json:"updatedBy,omitempty"`
+	ApprovedBy              string                  `json:"approvedBy,omitempty"`
+	ApprovedAt              *time.Time              `json:"approvedAt,omitempty"`
+	SeriesID                string                  `json:"seriesId,omitempty"`       // 定期予約の回の場合
+	OccurrenceDate          string                  `json:"occurrenceDate,omitempty"` // 定期予約の回の日付
 	Options                 []BookingOptionResponse `json:"options,omitempty"`
 }
 
@@ -0,0 +1,323 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/auth"
+	"github.com/zebraApp/internal/models"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// bcryptCost はパスワードハッシュのコスト（Lambda実装のSALT_ROUNDSに合わせる）
+const bcryptCost = 12
+
+var (
+	// ErrEmailAlreadyExists はメールアドレスが登録済みであることを表す
+	ErrEmailAlreadyExists = NewConflictError("EMAIL_EXISTS", "auth.email_exists")
+	// ErrInvalidCredentials はメールアドレスまたはパスワードの不一致を表す
+	ErrInvalidCredentials = NewUnauthorizedError("INVALID_CREDENTIALS", "auth.invalid_credentials")
+	// ErrInvalidRefreshToken は存在しない・無効化済みのリフレッシュトークンを表す
+	ErrInvalidRefreshToken = NewUnauthorizedError("INVALID_TOKEN", "auth.invalid_refresh_token")
+	// ErrRefreshTokenExpired はリフレッシュトークンの期限切れを表す
+	ErrRefreshTokenExpired = NewUnauthorizedError("TOKEN_EXPIRED", "auth.refresh_token_expired")
+)
+
+type AuthServiceImpl struct {
+	db              *gorm.DB
+	jwtSecret       []byte
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+}
+
+func NewAuthService(db *gorm.DB, jwtSecret string, accessTokenTTL, refreshTokenTTL time.Duration) *AuthServiceImpl {
+	return &AuthServiceImpl{
+		db:              db,
+		jwtSecret:       []byte(jwtSecret),
+		accessTokenTTL:  accessTokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+	}
+}
+
+// Register ユーザー登録
+func (s *AuthServiceImpl) Register(req RegisterRequest, client ClientInfo) (*AuthResponse, error) {
+	email := normalizeEmail(req.Email)
+
+	var count int64
+	if err := s.db.Model(&models.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("メールアドレスの確認に失敗しました: %w", err)
+	}
+	if count > 0 {
+		return nil, ErrEmailAlreadyExists
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcryptCost)
+	if err != nil {
+		return nil, fmt.Errorf("パスワードのハッシュ化に失敗しました: %w", err)
+	}
+
+	user := models.User{
+		ID:             uuid.New(),
+		Email:          email,
+		HashedPassword: string(hashedPassword),
+		FullName:       req.FullName,
+		Address:        req.Address,
+		Phone:          req.Phone,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	var tokens *TokenResponse
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			// 事前の確認の後に同じメールアドレスで登録された場合は一意制約で検出する
+			if isUniqueViolation(err, userEmailConstraint) {
+				return ErrEmailAlreadyExists
+			}
+			return fmt.Errorf("ユーザーの作成に失敗しました: %w", err)
+		}
+
+		var err error
+		tokens, _, err = s.issueTokens(tx, user, client)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthResponse{User: convertToAuthUser(user), Tokens: *tokens}, nil
+}
+
+// Login ログイン
+func (s *AuthServiceImpl) Login(req LoginRequest, client ClientInfo) (*AuthResponse, error) {
+	var user models.User
+	if err := s.db.First(&user, "email = ?", normalizeEmail(req.Email)).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// ユーザーの存在有無で応答時間が変わらないようにダミー比較を行う
+			bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.Password))
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("ユーザーの取得に失敗しました: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(req.Password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	tokens, _, err := s.issueTokens(s.db, user, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthResponse{User: convertToAuthUser(user), Tokens: *tokens}, nil
+}
+
+// Refresh リフレッシュトークンのローテーション
+// 使用済みのトークンは無効化され、新しいトークンペアが発行される
+func (s *AuthServiceImpl) Refresh(refreshToken string, client ClientInfo) (*TokenResponse, error) {
+	var tokens *TokenResponse
+	var reused *models.RefreshToken
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var stored models.RefreshToken
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&stored, "token_hash = ?", hashToken(refreshToken)).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRefreshToken
+			}
+			return fmt.Errorf("リフレッシュトークンの取得に失敗しました: %w", err)
+		}
+
+		// 無効化済みトークンの再利用は漏洩の可能性があるため、トランザクション外で全トークンを無効化する
+		if stored.RevokedAt != nil {
+			reused = &stored
+			return ErrInvalidRefreshToken
+		}
+
+		if time.Now().After(stored.ExpiresAt) {
+			return ErrRefreshTokenExpired
+		}
+
+		var user models.User
+		if err := tx.First(&user, "id = ?", stored.UserID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRefreshToken
+			}
+			return fmt.Errorf("ユーザーの取得に失敗しました: %w", err)
+		}
+
+		var newTokenID uuid.UUID
+		var err error
+		tokens, newTokenID, err = s.issueTokens(tx, user, client)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if err := tx.Model(&stored).Updates(map[string]interface{}{
+			"revoked_at":  now,
+			"replaced_by": newTokenID,
+		}).Error; err != nil {
+			return fmt.Errorf("リフレッシュトークンの無効化に失敗しました: %w", err)
+		}
+
+		return nil
+	})
+
+	if reused != nil {
+		if err := s.revokeAllForUser(reused.UserID); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// Logout リフレッシュトークンの無効化
+// 未知のトークンや無効化済みのトークンに対してもエラーにはしない
+func (s *AuthServiceImpl) Logout(refreshToken string) error {
+	if err := s.db.Model(&models.RefreshToken{}).
+		Where("token_hash = ? AND revoked_at IS NULL", hashToken(refreshToken)).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("リフレッシュトークンの無効化に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// issueTokens アクセストークンとリフレッシュトークンを発行し、リフレッシュトークンを保存する
+func (s *AuthServiceImpl) issueTokens(tx *gorm.DB, user models.User, client ClientInfo) (*TokenResponse, uuid.UUID, error) {
+	now := time.Now()
+
+	accessToken, err := auth.IssueAccessToken(auth.Principal{
+		ID:      user.ID.String(),
+		Email:   user.Email,
+		IsAdmin: user.IsAdmin,
+	}, user.FullName, s.jwtSecret, s.accessTokenTTL, now)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	refreshToken, err := generateOpaqueToken()
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	stored := models.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: now.Add(s.refreshTokenTTL),
+		UserAgent: client.UserAgent,
+		IPAddress: client.IPAddress,
+		CreatedAt: now,
+	}
+	if err := tx.Create(&stored).Error; err != nil {
+		return nil, uuid.Nil, fmt.Errorf("リフレッシュトークンの保存に失敗しました: %w", err)
+	}
+
+	return &TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(s.accessTokenTTL.Seconds()),
+	}, stored.ID, nil
+}
+
+// revokeAllForUser ユーザーの有効なリフレッシュトークンをすべて無効化する
+func (s *AuthServiceImpl) revokeAllForUser(userID uuid.UUID) error {
+	if err := s.db.Model(&models.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("リフレッシュトークンの一括無効化に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// dummyPasswordHash はユーザーが存在しない場合の比較用ハッシュ（bcryptCostで生成済み）
+var dummyPasswordHash = []byte("$2a$12$SgW.LJkc/KEE6FDO7krepOkrZEWxzFUomz/t4NL7R7KRcYMEvStlK")
+
+// generateOpaqueToken 推測不可能なランダムトークンを生成
+func generateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("トークンの生成に失敗しました: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken トークンを保存用にハッシュ化
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func convertToAuthUser(user models.User) AuthUser {
+	return AuthUser{
+		ID:        user.ID.String(),
+		Email:     user.Email,
+		FullName:  user.FullName,
+		Address:   user.Address,
+		Phone:     user.Phone,
+		IsAdmin:   user.IsAdmin,
+		CreatedAt: user.CreatedAt,
+	}
+}
+
+// コントローラーと共有するリクエスト・レスポンス型
+type RegisterRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=8"`
+	FullName string `json:"fullName" validate:"required"`
+	Address  string `json:"address,omitempty"`
+	Phone    string `json:"phone,omitempty"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	ExpiresIn    int    `json:"expiresIn"`
+}
+
+type AuthUser struct {
+	ID        string    `json:"userId"`
+	Email     string    `json:"email"`
+	FullName  string    `json:"fullName"`
+	Address   string    `json:"address,omitempty"`
+	Phone     string    `json:"phone,omitempty"`
+	IsAdmin   bool      `json:"isAdmin"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type AuthResponse struct {
+	User   AuthUser      `json:"user"`
+	Tokens TokenResponse `json:"tokens"`
+}
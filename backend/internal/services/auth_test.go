@@ -0,0 +1,34 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	duplicateEmail := &pgconn.PgError{Code: pgUniqueViolation, ConstraintName: userEmailConstraint}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"duplicate email", duplicateEmail, true},
+		{"wrapped duplicate email", fmt.Errorf("insert: %w", duplicateEmail), true},
+		{"other unique constraint", &pgconn.PgError{Code: pgUniqueViolation, ConstraintName: "users_pkey"}, false},
+		{"exclusion violation", &pgconn.PgError{Code: pgExclusionViolation, ConstraintName: userEmailConstraint}, false},
+		{"not a database error", errors.New("connection refused"), false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isUniqueViolation(tt.err, userEmailConstraint); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
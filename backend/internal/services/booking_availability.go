@@ -0,0 +1,165 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/schedule"
+)
+
+const (
+	// alternativeWindowLimit は提案する代替枠の最大件数
+	alternativeWindowLimit = 3
+	// alternativeSearchDays は代替枠を探す範囲（希望日時の前後の日数）
+	alternativeSearchDays = 7
+)
+
+// AvailabilityCheckResponse は空き状況確認の結果
+type AvailabilityCheckResponse struct {
+	Available bool                 `json:"available"`
+	Conflicts []ConflictingBooking `json:"conflicts,omitempty"`
+	// Held は他の予約の変更申請で仮押さえされている時間帯と重なることを表す
+	Held         bool                `json:"held,omitempty"`
+	Alternatives []AlternativeWindow `json:"alternatives,omitempty"`
+	Message      string              `json:"message,omitempty"`
+}
+
+// ConflictingBooking は希望の時間帯と重なる予約
+type ConflictingBooking struct {
+	ID          string    `json:"id"`
+	StartTime   time.Time `json:"startTime"`
+	EndTime     time.Time `json:"endTime"`
+	UserName    string    `json:"userName"`
+	Status      string    `json:"status"`
+	BookingType string    `json:"bookingType"`
+	KeepOrder   int       `json:"keepOrder"`
+}
+
+// AlternativeWindow は希望と同じ長さの空き枠
+type AlternativeWindow struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+}
+
+// CheckAvailability 空き状況確認
+// 重なる予約や変更申請の仮押さえがある場合は、予約の一覧と希望日時に近い代替枠を返す
+func (s *AdminBookingServiceImpl) CheckAvailability(startTime, endTime time.Time, excludeBookingID string) (*AvailabilityCheckResponse, error) {
+	var overlapping []models.Booking
+	query := s.db.Preload("User").
+		Where("start_time < ? AND end_time > ? AND status IN ?", endTime, startTime, activeBookingStatuses)
+	if excludeBookingID != "" {
+		query = query.Where("id <> ?", excludeBookingID)
+	}
+	if err := query.Order("start_time ASC, keep_order ASC").Find(&overlapping).Error; err != nil {
+		return nil, fmt.Errorf("重複する予約の取得に失敗しました: %w", err)
+	}
+
+	held := false
+	if err := ensureSlotNotHeld(s.db, startTime, endTime, excludeBookingID); err != nil {
+		if !errors.Is(err, ErrTimeSlotHeld) {
+			return nil, err
+		}
+		held = true
+	}
+
+	if len(overlapping) == 0 && !held {
+		return &AvailabilityCheckResponse{Available: true}, nil
+	}
+
+	conflicts := make([]ConflictingBooking, len(overlapping))
+	for i, b := range overlapping {
+		conflict := ConflictingBooking{
+			ID:          b.ID.String(),
+			StartTime:   b.StartTime,
+			EndTime:     b.EndTime,
+			Status:      string(b.Status),
+			BookingType: string(b.BookingType),
+			KeepOrder:   b.KeepOrder,
+		}
+		if b.User != nil {
+			conflict.UserName = b.User.FullName
+		}
+		conflicts[i] = conflict
+	}
+
+	alternatives, err := s.findAlternativeWindows(startTime, endTime, excludeBookingID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvailabilityCheckResponse{
+		Available:    false,
+		Conflicts:    conflicts,
+		Held:         held,
+		Alternatives: alternatives,
+	}, nil
+}
+
+// findAlternativeWindows 希望日時の前後から、営業時間内で空いている同じ長さの枠を探す
+func (s *AdminBookingServiceImpl) findAlternativeWindows(startTime, endTime time.Time, excludeBookingID string, now time.Time) ([]AlternativeWindow, error) {
+	duration := endTime.Sub(startTime)
+	horizon := time.Duration(alternativeSearchDays) * 24 * time.Hour
+
+	calendar, err := s.validator.BusinessCalendar()
+	if err != nil {
+		return nil, err
+	}
+
+	// 探索範囲に掛かる予約を使用中の時間帯として読み込む
+	var busy []schedule.Interval
+	query := s.db.Model(&models.Booking{}).
+		Select("start_time", "end_time").
+		Where("start_time < ? AND end_time > ? AND status IN ?",
+			endTime.Add(horizon), startTime.Add(-horizon), activeBookingStatuses)
+	if excludeBookingID != "" {
+		query = query.Where("id <> ?", excludeBookingID)
+	}
+	rows, err := query.Rows()
+	if err != nil {
+		return nil, fmt.Errorf("予約の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i schedule.Interval
+		if err := rows.Scan(&i.Start, &i.End); err != nil {
+			return nil, fmt.Errorf("予約の取得に失敗しました: %w", err)
+		}
+		busy = append(busy, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("予約の取得に失敗しました: %w", err)
+	}
+
+	// 変更申請で仮押さえされている時間帯も使用中とする
+	var held []models.BookingChangeRequest
+	heldQuery := s.db.Select("start_time", "end_time").
+		Where("status = ? AND start_time < ? AND end_time > ?",
+			models.ChangeRequestStatusPending, endTime.Add(horizon), startTime.Add(-horizon))
+	if excludeBookingID != "" {
+		heldQuery = heldQuery.Where("booking_id <> ?", excludeBookingID)
+	}
+	if err := heldQuery.Find(&held).Error; err != nil {
+		return nil, fmt.Errorf("仮押さえの取得に失敗しました: %w", err)
+	}
+	for _, r := range held {
+		busy = append(busy, schedule.Interval{Start: *r.StartTime, End: *r.EndTime})
+	}
+
+	windows := schedule.NewTimeline(busy).Alternatives(schedule.AlternativeQuery{
+		Start:     startTime,
+		Duration:  duration,
+		Step:      s.validator.Interval(),
+		Horizon:   horizon,
+		NotBefore: now,
+		Limit:     alternativeWindowLimit,
+		Accept:    calendar.Contains,
+	})
+
+	alternatives := make([]AlternativeWindow, len(windows))
+	for i, w := range windows {
+		alternatives[i] = AlternativeWindow{StartTime: w.Start, EndTime: w.End}
+	}
+	return alternatives, nil
+}
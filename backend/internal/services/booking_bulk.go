@@ -0,0 +1,164 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// bulkBookingActionLimit は一度に処理できる予約の最大件数
+const bulkBookingActionLimit = 50
+
+var (
+	// ErrBulkBookingIDsRequired は一括処理の対象の予約が指定されていないことを表す
+	ErrBulkBookingIDsRequired = NewValidationError("INVALID_PARAMETERS", "booking.bulk_ids_required")
+	// ErrBulkBookingLimitExceeded は一括処理の対象が上限を超えていることを表す
+	ErrBulkBookingLimitExceeded = NewValidationError("INVALID_PARAMETERS", "booking.bulk_limit_exceeded", bulkBookingActionLimit)
+	// ErrBulkActionRolledBack は一括モードで他の予約の処理に失敗したため変更を取り消したことを表す
+	ErrBulkActionRolledBack = NewConflictError("BULK_ACTION_ROLLED_BACK", "booking.bulk_rolled_back")
+)
+
+// errBulkActionFailed は一括モードでトランザクションをロールバックさせるために内部でのみ使用する
+var errBulkActionFailed = errors.New("bulk booking action failed")
+
+// BulkBookingActionRequest は管理者による予約の一括操作のリクエスト
+type BulkBookingActionRequest struct {
+	BookingIDs []string      `json:"bookingIds"`
+	Action     BookingAction `json:"action"`
+	BookingDecisionRequest
+	// Atomic は1件でも失敗した場合にすべての変更を取り消す（既定は1件ずつ確定する）
+	Atomic bool `json:"atomic,omitempty"`
+}
+
+// BulkBookingActionResult は一括操作の予約ごとの結果
+type BulkBookingActionResult struct {
+	BookingID string `json:"bookingId"`
+	Success   bool   `json:"success"`
+	// Status は処理後のステータス（成功した場合のみ）
+	Status string `json:"status,omitempty"`
+	// Code・Messageは失敗理由。Errからレスポンスの言語で設定する
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Err     error  `json:"-"`
+}
+
+// BulkBookingActionResponse は一括操作の結果
+type BulkBookingActionResponse struct {
+	Action BookingAction `json:"action"`
+	Atomic bool          `json:"atomic"`
+	// Committed は変更が確定したかどうか（一括モードで失敗があった場合はfalse）
+	Committed      bool                      `json:"committed"`
+	TotalProcessed int                       `json:"totalProcessed"`
+	SuccessCount   int                       `json:"successCount"`
+	FailureCount   int                       `json:"failureCount"`
+	Results        []BulkBookingActionResult `json:"results"`
+	ProcessedAt    time.Time                 `json:"processedAt"`
+}
+
+// BulkBookingAction 複数の予約に同じ操作を行い、予約ごとの結果を返す
+// 通常は予約ごとに個別のトランザクションで処理し、失敗した予約があっても他の予約の変更は確定する。
+// Atomicを指定した場合は1つのトランザクションで処理し、1件でも失敗した場合はすべての変更を取り消す
+func (s *AdminBookingServiceImpl) BulkBookingAction(req BulkBookingActionRequest, adminID string) (*BulkBookingActionResponse, error) {
+	bookingIDs := uniqueBookingIDs(req.BookingIDs)
+	if len(bookingIDs) == 0 {
+		return nil, ErrBulkBookingIDsRequired
+	}
+	if len(bookingIDs) > bulkBookingActionLimit {
+		return nil, ErrBulkBookingLimitExceeded
+	}
+	if err := req.validate(req.Action); err != nil {
+		return nil, err
+	}
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("管理者IDが無効です: %w", err)
+	}
+
+	response := &BulkBookingActionResponse{
+		Action:         req.Action,
+		Atomic:         req.Atomic,
+		TotalProcessed: len(bookingIDs),
+		Results:        make([]BulkBookingActionResult, 0, len(bookingIDs)),
+	}
+
+	// apply 予約1件を処理して結果を記録する
+	apply := func(tx *gorm.DB, bookingID string) bool {
+		result := BulkBookingActionResult{BookingID: bookingID}
+		err := tx.Transaction(func(tx *gorm.DB) error {
+			transition, err := s.applyBookingAction(tx, bookingID, req.Action, req.BookingDecisionRequest, adminUUID)
+			if err != nil {
+				return err
+			}
+			result.Status = string(transition.To.Status)
+			return nil
+		})
+		result.Success = err == nil
+		result.Err = err
+		response.Results = append(response.Results, result)
+		return result.Success
+	}
+
+	if !req.Atomic {
+		for _, bookingID := range bookingIDs {
+			apply(s.db, bookingID)
+		}
+		response.Committed = true
+	} else {
+		// 予約ごとにセーブポイントを置き、失敗した予約があっても残りの予約の結果を報告する
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			failed := false
+			for _, bookingID := range bookingIDs {
+				if !apply(tx, bookingID) {
+					failed = true
+				}
+			}
+			if failed {
+				return errBulkActionFailed
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errBulkActionFailed) {
+			return nil, fmt.Errorf("一括処理のコミットに失敗しました: %w", err)
+		}
+		response.Committed = err == nil
+
+		// 取り消した予約は失敗として報告する
+		if !response.Committed {
+			for i := range response.Results {
+				if response.Results[i].Success {
+					response.Results[i] = BulkBookingActionResult{
+						BookingID: response.Results[i].BookingID,
+						Err:       ErrBulkActionRolledBack,
+					}
+				}
+			}
+		}
+	}
+
+	for _, result := range response.Results {
+		if result.Success {
+			response.SuccessCount++
+		} else {
+			response.FailureCount++
+		}
+	}
+	response.ProcessedAt = time.Now()
+	return response, nil
+}
+
+// uniqueBookingIDs 空のIDと重複を除いた予約IDを指定順に返す
+func uniqueBookingIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
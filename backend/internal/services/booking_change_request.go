@@ -0,0 +1,596 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/pricing"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrChangeRequestNotFound は変更申請が存在しないことを表す
+	ErrChangeRequestNotFound = NewNotFoundError("CHANGE_REQUEST_NOT_FOUND", "booking_change.not_found")
+	// ErrChangeRequestEmpty は変更する内容が指定されていないことを表す
+	ErrChangeRequestEmpty = NewValidationError("INVALID_PARAMETERS", "booking_change.nothing_requested")
+	// ErrChangeRequestAlreadyPending は同じ予約に申請中の変更があることを表す
+	ErrChangeRequestAlreadyPending = NewConflictError("CHANGE_REQUEST_PENDING", "booking_change.already_pending")
+	// ErrChangeRequestNotPending は申請中以外の変更申請を承認・却下しようとしたことを表す
+	ErrChangeRequestNotPending = NewConflictError("CHANGE_REQUEST_CLOSED", "booking_change.not_pending")
+	// ErrBookingNotChangeable は変更を申請できない状態の予約であることを表す
+	ErrBookingNotChangeable = NewConflictError("BOOKING_CANNOT_BE_UPDATED", "booking_change.booking_not_changeable")
+	// ErrInvalidChangeRequestStatus は定義されていない変更申請のステータスが指定されたことを表す
+	ErrInvalidChangeRequestStatus = NewValidationError("INVALID_STATUS", "booking_change.invalid_status")
+)
+
+// BookingChangeRequestServiceImpl は顧客からの予約変更申請を扱う
+// 申請中は希望する時間帯を仮押さえし、管理者が承認した時点で予約の時間帯・オプションを置き換える
+type BookingChangeRequestServiceImpl struct {
+	db        *gorm.DB
+	keepQueue *KeepQueueServiceImpl
+	states    *BookingStateMachine
+	pricing   *pricing.Engine
+	validator *BookingValidatorImpl
+}
+
+func NewBookingChangeRequestService(db *gorm.DB, cancellationPolicy CancellationPolicy, pricingEngine *pricing.Engine, validator *BookingValidatorImpl) *BookingChangeRequestServiceImpl {
+	return &BookingChangeRequestServiceImpl{
+		db:        db,
+		keepQueue: NewKeepQueueService(db),
+		states:    NewBookingStateMachine(db, cancellationPolicy, pricingEngine),
+		pricing:   pricingEngine,
+		validator: validator,
+	}
+}
+
+// RequestChange 顧客による予約変更の申請
+// 希望する時間帯は申請の時点で空いている必要があり、承認・却下されるまで他の予約を受け付けない
+func (s *BookingChangeRequestServiceImpl) RequestChange(bookingID, userID string, req CreateChangeRequestRequest) (*BookingChangeRequestResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザーIDが無効です: %w", err)
+	}
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return nil, ErrBookingNotFound
+	}
+
+	var booking models.Booking
+	if err := s.db.First(&booking, "id = ?", bookingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBookingNotFound
+		}
+		return nil, fmt.Errorf("予約の確認に失敗しました: %w", err)
+	}
+	if booking.UserID == nil || *booking.UserID != userUUID {
+		return nil, ErrNotBookingOwner
+	}
+
+	now := time.Now()
+	changeRequest := models.BookingChangeRequest{
+		ID:          uuid.New(),
+		BookingID:   booking.ID,
+		RequestedBy: &userUUID,
+		Status:      models.ChangeRequestStatusPending,
+		Reason:      strings.TrimSpace(req.Reason),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	// 現在と同じ時間帯は時間帯の変更として扱わない
+	if req.StartTime != nil && req.EndTime != nil &&
+		(!req.StartTime.Equal(booking.StartTime) || !req.EndTime.Equal(booking.EndTime)) {
+		changeRequest.StartTime, changeRequest.EndTime = req.StartTime, req.EndTime
+	}
+	if req.Options != nil {
+		options := make(models.RequestedOptions, len(req.Options))
+		for i, selected := range req.Options {
+			options[i] = models.RequestedOption{OptionID: selected.OptionID, Quantity: selected.Quantity}
+		}
+		changeRequest.Options = &options
+	}
+	if !changeRequest.ChangesSlot() && changeRequest.Options == nil {
+		return nil, ErrChangeRequestEmpty
+	}
+
+	// 利用開始前の有効な予約のみ変更を申請できる
+	if !booking.Status.IsActive() || !booking.StartTime.After(now) {
+		return nil, ErrBookingNotChangeable
+	}
+
+	// 変更後の時間帯で業務ルールを検証する
+	if changeRequest.ChangesSlot() {
+		candidate := booking
+		candidate.StartTime, candidate.EndTime = *changeRequest.StartTime, *changeRequest.EndTime
+		if err := s.validator.ValidateBooking(s.db, candidate, now); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		locked, err := lockBooking(tx, bookingID)
+		if err != nil {
+			return err
+		}
+		if !locked.Status.IsActive() {
+			return ErrBookingNotChangeable
+		}
+		changeRequest.PreviousStartTime, changeRequest.PreviousEndTime = locked.StartTime, locked.EndTime
+
+		var pending int64
+		if err := tx.Model(&models.BookingChangeRequest{}).
+			Where("booking_id = ? AND status = ?", locked.ID, models.ChangeRequestStatusPending).
+			Count(&pending).Error; err != nil {
+			return fmt.Errorf("変更申請の確認に失敗しました: %w", err)
+		}
+		if pending > 0 {
+			return ErrChangeRequestAlreadyPending
+		}
+
+		if changeRequest.ChangesSlot() {
+			if err := ensureSlotFree(tx, locked, *changeRequest.StartTime, *changeRequest.EndTime); err != nil {
+				return err
+			}
+		}
+		if changeRequest.Options != nil {
+			for _, option := range *changeRequest.Options {
+				if err := validateRequestedOption(tx, option); err != nil {
+					return err
+				}
+			}
+		}
+
+		// 申請中の変更申請が希望する時間帯の仮押さえとなる
+		if err := tx.Create(&changeRequest).Error; err != nil {
+			if isExclusionViolation(err, changeRequestHoldConstraint) {
+				return ErrTimeSlotHeld
+			}
+			return fmt.Errorf("変更申請の作成に失敗しました: %w", err)
+		}
+
+		summary := describeChangeRequest(changeRequest)
+		if err := logBookingEvent(tx, locked, &userUUID,
+			fmt.Sprintf("ユーザーによる予約変更の申請（%s）", summary), changeRequest.Reason); err != nil {
+			return err
+		}
+
+		if err := notifyBookingUser(tx, locked, models.NotificationTypeBooking,
+			"予約の変更を申請しました",
+			fmt.Sprintf("%sの予約の変更を申請しました。\n%s\n管理者の確認をお待ちください。", formatBookingPeriod(locked), summary)); err != nil {
+			return err
+		}
+
+		content := fmt.Sprintf("%sの予約の変更が申請されました。\n%s", formatBookingPeriod(locked), summary)
+		if changeRequest.Reason != "" {
+			content += "\n理由: " + changeRequest.Reason
+		}
+		return notifyAdmins(tx, locked.ID, "予約の変更申請があります", content)
+	})
+	if errors.Is(err, errBookingOverlap) {
+		return nil, newBookingConflictError(s.db, *changeRequest.StartTime, *changeRequest.EndTime, bookingID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getChangeRequest(changeRequest.ID.String())
+}
+
+// GetBookingChangeRequests 自分の予約に対する変更申請の一覧（新しい順）
+func (s *BookingChangeRequestServiceImpl) GetBookingChangeRequests(bookingID, userID string) ([]BookingChangeRequestResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザーIDが無効です: %w", err)
+	}
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return nil, ErrBookingNotFound
+	}
+
+	var booking models.Booking
+	if err := s.db.First(&booking, "id = ?", bookingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBookingNotFound
+		}
+		return nil, fmt.Errorf("予約の確認に失敗しました: %w", err)
+	}
+	if booking.UserID == nil || *booking.UserID != userUUID {
+		return nil, ErrNotBookingOwner
+	}
+
+	var changeRequests []models.BookingChangeRequest
+	if err := s.db.Where("booking_id = ?", booking.ID).
+		Order("created_at DESC").
+		Find(&changeRequests).Error; err != nil {
+		return nil, fmt.Errorf("変更申請の取得に失敗しました: %w", err)
+	}
+
+	responses := make([]BookingChangeRequestResponse, len(changeRequests))
+	for i, r := range changeRequests {
+		responses[i] = convertToChangeRequestResponse(r)
+	}
+	return responses, nil
+}
+
+// ListChangeRequests 管理者向けの変更申請一覧（申請の古い順）
+// statusを省略した場合は申請中のもの、"all"の場合はすべてを返す
+func (s *BookingChangeRequestServiceImpl) ListChangeRequests(status string, limit int) ([]BookingChangeRequestResponse, error) {
+	query := s.db.Preload("Booking").
+		Preload("Booking.User").
+		Preload("Booking.BookingOptions").
+		Preload("Booking.BookingOptions.Option")
+
+	switch models.BookingChangeRequestStatus(status) {
+	case "":
+		query = query.Where("status = ?", models.ChangeRequestStatusPending)
+	case "all":
+	case models.ChangeRequestStatusPending, models.ChangeRequestStatusAccepted,
+		models.ChangeRequestStatusDeclined, models.ChangeRequestStatusCancelled:
+		query = query.Where("status = ?", status)
+	default:
+		return nil, ErrInvalidChangeRequestStatus
+	}
+
+	var changeRequests []models.BookingChangeRequest
+	if err := query.Order("created_at ASC").Limit(limit).Find(&changeRequests).Error; err != nil {
+		return nil, fmt.Errorf("変更申請の取得に失敗しました: %w", err)
+	}
+
+	responses := make([]BookingChangeRequestResponse, len(changeRequests))
+	for i, r := range changeRequests {
+		responses[i] = convertToChangeRequestResponse(r)
+	}
+	return responses, nil
+}
+
+// AcceptChangeRequest 変更申請を承認し、予約の時間帯・オプションを置き換える
+// 予約の更新・料金の再計算・ステータスログの記録・仮押さえの解除を1つのトランザクションで行う
+func (s *BookingChangeRequestServiceImpl) AcceptChangeRequest(requestID, adminID string, req ChangeRequestDecisionRequest) (*BookingChangeRequestResponse, error) {
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("管理者IDが無効です: %w", err)
+	}
+
+	var changeRequest models.BookingChangeRequest
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		locked, err := lockChangeRequest(tx, requestID)
+		if err != nil {
+			return err
+		}
+		changeRequest = locked
+		if changeRequest.Status != models.ChangeRequestStatusPending {
+			return ErrChangeRequestNotPending
+		}
+
+		booking, err := lockBooking(tx, changeRequest.BookingID.String())
+		if err != nil {
+			return err
+		}
+
+		// 承認時点の業務ルールで変更後の時間帯を検証する
+		updates := map[string]interface{}{}
+		if changeRequest.ChangesSlot() {
+			startTime, endTime := *changeRequest.StartTime, *changeRequest.EndTime
+			candidate := booking
+			candidate.StartTime, candidate.EndTime = startTime, endTime
+			if err := s.validator.ValidateBooking(tx, candidate, time.Now()); err != nil {
+				return err
+			}
+
+			// 仮押さえしていた時間帯でキープ順序を決め直す（自身の仮押さえは対象外）
+			keepOrder, err := s.keepQueue.AssignKeepOrder(tx, startTime, endTime, booking.BookingType, booking.ID.String())
+			if err != nil {
+				return err
+			}
+			updates["start_time"] = startTime
+			updates["end_time"] = endTime
+			updates["keep_order"] = keepOrder
+
+			// 仮予約の確認期限は利用開始時刻を超えない
+			if booking.ConfirmationDeadline != nil && booking.ConfirmationDeadline.After(startTime) {
+				updates["confirmation_deadline"] = startTime
+			}
+		}
+
+		// 元の時間枠の明け渡しとステータスログの記録は状態遷移で行う
+		summary := describeChangeRequest(changeRequest)
+		if _, err := s.states.Transition(tx, BookingTransition{
+			Booking:     booking,
+			Actor:       BookingActorAdmin,
+			ChangedBy:   &adminUUID,
+			Note:        fmt.Sprintf("予約変更申請の承認（%s）", summary),
+			Reason:      strings.TrimSpace(req.Reason),
+			Updates:     updates,
+			SlotChanged: changeRequest.ChangesSlot(),
+			Record:      true,
+		}); err != nil {
+			if isBookingOverlapViolation(err) {
+				return errBookingOverlap
+			}
+			return err
+		}
+
+		if changeRequest.Options != nil {
+			if err := tx.Where("booking_id = ?", booking.ID).Delete(&models.BookingOption{}).Error; err != nil {
+				return fmt.Errorf("既存オプションの削除に失敗しました: %w", err)
+			}
+			for _, option := range *changeRequest.Options {
+				if err := createBookingOption(tx, booking.ID, SelectedOptionRequest{OptionID: option.OptionID, Quantity: option.Quantity}); err != nil {
+					return err
+				}
+			}
+		}
+
+		// 時間帯・オプションの変更を反映して料金を再計算
+		if err := snapshotBookingPrice(tx, s.pricing, booking.ID); err != nil {
+			return err
+		}
+
+		// 申請を確定すると仮押さえが解除される
+		if err := decideChangeRequest(tx, &changeRequest, models.ChangeRequestStatusAccepted, adminUUID, req.Reason); err != nil {
+			return err
+		}
+
+		content := fmt.Sprintf("%sの予約の変更が承認されました。\n%s", formatBookingPeriod(booking), summary)
+		return notifyBookingUser(tx, booking, models.NotificationTypeBooking, "予約の変更が承認されました", content)
+	})
+	if errors.Is(err, errBookingOverlap) {
+		return nil, newBookingConflictError(s.db, *changeRequest.StartTime, *changeRequest.EndTime, changeRequest.BookingID.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getChangeRequest(requestID)
+}
+
+// DeclineChangeRequest 変更申請を却下し、希望の時間帯の仮押さえを解除する
+// 予約は申請前の内容のまま変わらない
+func (s *BookingChangeRequestServiceImpl) DeclineChangeRequest(requestID, adminID string, req ChangeRequestDecisionRequest) (*BookingChangeRequestResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, ErrDecisionReasonRequired
+	}
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("管理者IDが無効です: %w", err)
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		changeRequest, err := lockChangeRequest(tx, requestID)
+		if err != nil {
+			return err
+		}
+		if changeRequest.Status != models.ChangeRequestStatusPending {
+			return ErrChangeRequestNotPending
+		}
+
+		var booking models.Booking
+		if err := tx.First(&booking, "id = ?", changeRequest.BookingID).Error; err != nil {
+			return fmt.Errorf("予約の確認に失敗しました: %w", err)
+		}
+
+		if err := decideChangeRequest(tx, &changeRequest, models.ChangeRequestStatusDeclined, adminUUID, reason); err != nil {
+			return err
+		}
+
+		summary := describeChangeRequest(changeRequest)
+		if err := logBookingEvent(tx, booking, &adminUUID,
+			fmt.Sprintf("予約変更申請の却下（%s）", summary), reason); err != nil {
+			return err
+		}
+
+		content := fmt.Sprintf("%sの予約の変更は承認されませんでした。\n%s\n理由: %s", formatBookingPeriod(booking), summary, reason)
+		return notifyBookingUser(tx, booking, models.NotificationTypeBooking, "予約の変更が承認されませんでした", content)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getChangeRequest(requestID)
+}
+
+// getChangeRequest 変更申請を対象の予約とともに取得する
+func (s *BookingChangeRequestServiceImpl) getChangeRequest(requestID string) (*BookingChangeRequestResponse, error) {
+	if _, err := uuid.Parse(requestID); err != nil {
+		return nil, ErrChangeRequestNotFound
+	}
+
+	var changeRequest models.BookingChangeRequest
+	if err := s.db.Preload("Booking").
+		Preload("Booking.User").
+		Preload("Booking.BookingOptions").
+		Preload("Booking.BookingOptions.Option").
+		First(&changeRequest, "id = ?", requestID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrChangeRequestNotFound
+		}
+		return nil, fmt.Errorf("変更申請の取得に失敗しました: %w", err)
+	}
+
+	response := convertToChangeRequestResponse(changeRequest)
+	return &response, nil
+}
+
+// lockChangeRequest 承認・却下する変更申請を行ロックして取得する
+func lockChangeRequest(tx *gorm.DB, requestID string) (models.BookingChangeRequest, error) {
+	var changeRequest models.BookingChangeRequest
+	if _, err := uuid.Parse(requestID); err != nil {
+		return changeRequest, ErrChangeRequestNotFound
+	}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&changeRequest, "id = ?", requestID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return changeRequest, ErrChangeRequestNotFound
+		}
+		return changeRequest, fmt.Errorf("変更申請の確認に失敗しました: %w", err)
+	}
+	return changeRequest, nil
+}
+
+// decideChangeRequest 変更申請の承認・却下を記録する
+func decideChangeRequest(tx *gorm.DB, changeRequest *models.BookingChangeRequest, status models.BookingChangeRequestStatus, adminUUID uuid.UUID, note string) error {
+	now := time.Now()
+	if err := tx.Model(changeRequest).Updates(map[string]interface{}{
+		"status":        status,
+		"decided_by":    adminUUID,
+		"decided_at":    now,
+		"decision_note": strings.TrimSpace(note),
+		"updated_at":    now,
+	}).Error; err != nil {
+		return fmt.Errorf("変更申請の更新に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// cancelPendingChangeRequests 予約の申請中の変更申請を取り下げ、仮押さえを解除する
+// 予約がキャンセル・拒否などで時間枠を占有しなくなった場合に状態遷移から呼び出す
+func cancelPendingChangeRequests(tx *gorm.DB, bookingID uuid.UUID, now time.Time) error {
+	if err := tx.Model(&models.BookingChangeRequest{}).
+		Where("booking_id = ? AND status = ?", bookingID, models.ChangeRequestStatusPending).
+		Updates(map[string]interface{}{
+			"status":     models.ChangeRequestStatusCancelled,
+			"updated_at": now,
+		}).Error; err != nil {
+		return fmt.Errorf("変更申請の取り下げに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// ensureSlotFree 希望する時間帯が予約自身以外の有効な予約・仮押さえと重ならないことを確認する
+// 変更申請ではキープとして順番待ちはせず、空いている時間帯のみ仮押さえできる
+func ensureSlotFree(tx *gorm.DB, booking models.Booking, startTime, endTime time.Time) error {
+	// 仮押さえの作成をコミットまで新規予約のキープ順序の割り当てと直列化する
+	if err := lockSlotHolds(tx); err != nil {
+		return err
+	}
+	overlapping, err := lockOverlappingBookings(tx, startTime, endTime, booking.ID.String())
+	if err != nil {
+		return err
+	}
+	if len(overlapping) > 0 {
+		return errBookingOverlap
+	}
+	return ensureSlotNotHeld(tx, startTime, endTime, booking.ID.String())
+}
+
+// validateRequestedOption 変更後のオプションが有効なオプションであることを確認する
+func validateRequestedOption(tx *gorm.DB, option models.RequestedOption) error {
+	optionID, err := uuid.Parse(option.OptionID)
+	if err != nil {
+		return ErrInvalidOption.WithDetails(map[string]interface{}{"optionId": option.OptionID})
+	}
+
+	var count int64
+	if err := tx.Model(&models.Option{}).
+		Where("id = ? AND is_active = ?", optionID, true).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("オプションの確認に失敗しました: %w", err)
+	}
+	if count == 0 {
+		return ErrInvalidOption.WithDetails(map[string]interface{}{"optionId": option.OptionID})
+	}
+	return nil
+}
+
+// logBookingEvent ステータスを変えない操作をステータスログに記録する
+func logBookingEvent(tx *gorm.DB, booking models.Booking, changedBy *uuid.UUID, note, reason string) error {
+	if reason != "" {
+		note = fmt.Sprintf("%s: %s", note, reason)
+	}
+	statusLog := models.BookingStatusLog{
+		ID:             uuid.New(),
+		BookingID:      booking.ID,
+		PreviousStatus: booking.Status,
+		NewStatus:      booking.Status,
+		ChangedBy:      changedBy,
+		ChangedAt:      time.Now(),
+		Note:           note,
+	}
+	if err := tx.Create(&statusLog).Error; err != nil {
+		return fmt.Errorf("ステータスログの記録に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// describeChangeRequest ステータスログ・通知に記載する変更内容
+func describeChangeRequest(changeRequest models.BookingChangeRequest) string {
+	var parts []string
+	if changeRequest.ChangesSlot() {
+		parts = append(parts, fmt.Sprintf("日時: %s → %s",
+			formatPeriod(changeRequest.PreviousStartTime, changeRequest.PreviousEndTime),
+			formatPeriod(*changeRequest.StartTime, *changeRequest.EndTime)))
+	}
+	if changeRequest.Options != nil {
+		parts = append(parts, "オプションの変更")
+	}
+	return strings.Join(parts, "、")
+}
+
+// convertToChangeRequestResponse モデルをレスポンス形式に変換
+func convertToChangeRequestResponse(changeRequest models.BookingChangeRequest) BookingChangeRequestResponse {
+	response := BookingChangeRequestResponse{
+		ID:                changeRequest.ID.String(),
+		BookingID:         changeRequest.BookingID.String(),
+		Status:            string(changeRequest.Status),
+		PreviousStartTime: changeRequest.PreviousStartTime,
+		PreviousEndTime:   changeRequest.PreviousEndTime,
+		StartTime:         changeRequest.StartTime,
+		EndTime:           changeRequest.EndTime,
+		Options:           changeRequest.Options,
+		Reason:            changeRequest.Reason,
+		DecidedAt:         changeRequest.DecidedAt,
+		DecisionNote:      changeRequest.DecisionNote,
+		CreatedAt:         changeRequest.CreatedAt,
+		UpdatedAt:         changeRequest.UpdatedAt,
+	}
+	if changeRequest.RequestedBy != nil {
+		response.RequestedBy = changeRequest.RequestedBy.String()
+	}
+	if changeRequest.DecidedBy != nil {
+		response.DecidedBy = changeRequest.DecidedBy.String()
+	}
+	if changeRequest.Booking != nil {
+		booking := convertToBookingResponse(*changeRequest.Booking)
+		response.Booking = &booking
+	}
+	return response
+}
+
+// コントローラーと共有するリクエスト・レスポンス型
+type CreateChangeRequestRequest struct {
+	// StartTime・EndTimeは希望する時間帯（時間帯を変更しない場合は省略）
+	StartTime *time.Time `json:"startTime,omitempty"`
+	EndTime   *time.Time `json:"endTime,omitempty"`
+	// Options は変更後のオプション。指定した場合は現在のオプションをすべて置き換える（空の配列はすべて外す）
+	Options []SelectedOptionRequest `json:"selectedOptions,omitempty"`
+	Reason  string                  `json:"reason,omitempty"`
+}
+
+// ChangeRequestDecisionRequest は管理者による変更申請の承認・却下のリクエスト
+type ChangeRequestDecisionRequest struct {
+	// Reason は承認・却下の理由（却下の場合は必須）。ステータスログと顧客への通知に記載する
+	Reason string `json:"reason,omitempty"`
+}
+
+type BookingChangeRequestResponse struct {
+	ID                string                   `json:"id"`
+	BookingID         string                   `json:"bookingId"`
+	RequestedBy       string                   `json:"requestedBy,omitempty"`
+	Status            string                   `json:"status"`
+	PreviousStartTime time.Time                `json:"previousStartTime"`
+	PreviousEndTime   time.Time                `json:"previousEndTime"`
+	StartTime         *time.Time               `json:"startTime,omitempty"`
+	EndTime           *time.Time               `json:"endTime,omitempty"`
+	Options           *models.RequestedOptions `json:"selectedOptions,omitempty"`
+	Reason            string                   `json:"reason,omitempty"`
+	DecidedBy         string                   `json:"decidedBy,omitempty"`
+	DecidedAt         *time.Time               `json:"decidedAt,omitempty"`
+	DecisionNote      string                   `json:"decisionNote,omitempty"`
+	CreatedAt         time.Time                `json:"createdAt"`
+	UpdatedAt         time.Time                `json:"updatedAt"`
+	Booking           *BookingResponse         `json:"booking,omitempty"`
+}
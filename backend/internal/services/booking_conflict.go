@@ -0,0 +1,98 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/zebraApp/internal/models"
+	"gorm.io/gorm"
+)
+
+const (
+	// pgExclusionViolation はPostgreSQLの排他制約違反のSQLSTATE
+	pgExclusionViolation = "23P01"
+	// pgUniqueViolation はPostgreSQLの一意制約違反のSQLSTATE
+	pgUniqueViolation = "23505"
+	// userEmailConstraint はユーザーのメールアドレスの一意制約名
+	userEmailConstraint = "users_email_key"
+	// bookingOverlapConstraint は予約時間帯の重複を禁止する排他制約名
+	bookingOverlapConstraint = "bookings_no_overlap"
+	// changeRequestHoldConstraint は変更申請の仮押さえ同士の重複を禁止する排他制約名
+	changeRequestHoldConstraint = "booking_change_requests_no_overlap"
+)
+
+// errBookingOverlap はトランザクション内で排他制約違反を検出したことを呼び出し元に伝える
+// 重複先の予約IDはロールバック後に取得する必要があるため、内部でのみ使用する
+var errBookingOverlap = errors.New("booking overlap constraint violated")
+
+// BookingConflictError は予約時間帯の重複を表すエラー
+// 事前チェックとデータベースの排他制約違反のどちらからも返される
+type BookingConflictError struct {
+	ConflictingBookingIDs []string
+}
+
+func (e *BookingConflictError) Error() string {
+	if len(e.ConflictingBookingIDs) == 0 {
+		return ErrTimeSlotUnavailable.Error()
+	}
+	return fmt.Sprintf("%s: %s", ErrTimeSlotUnavailable, strings.Join(e.ConflictingBookingIDs, ", "))
+}
+
+// Is によりerrors.Is(err, ErrTimeSlotUnavailable)で判定できるようにする
+func (e *BookingConflictError) Is(target error) bool {
+	return target == ErrTimeSlotUnavailable
+}
+
+// findOverlappingBookingIDs 指定時間帯と重複する有効な予約のIDを取得
+func findOverlappingBookingIDs(db *gorm.DB, startTime, endTime time.Time, excludeBookingID string) ([]string, error) {
+	query := db.Model(&models.Booking{}).
+		Where("start_time < ? AND end_time > ? AND status IN ?",
+			endTime, startTime, []models.BookingStatus{
+				models.BookingStatusPending,
+				models.BookingStatusApproved,
+			})
+
+	if excludeBookingID != "" {
+		query = query.Where("id != ?", excludeBookingID)
+	}
+
+	var ids []string
+	if err := query.Order("start_time ASC").Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("重複する予約の取得に失敗しました: %w", err)
+	}
+	return ids, nil
+}
+
+// isBookingOverlapViolation 排他制約違反によるエラーかどうか
+func isBookingOverlapViolation(err error) bool {
+	return isExclusionViolation(err, bookingOverlapConstraint)
+}
+
+// isExclusionViolation 指定の排他制約の違反によるエラーかどうか
+func isExclusionViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) &&
+		pgErr.Code == pgExclusionViolation &&
+		pgErr.ConstraintName == constraint
+}
+
+// isUniqueViolation 指定の一意制約の違反によるエラーかどうか
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) &&
+		pgErr.Code == pgUniqueViolation &&
+		pgErr.ConstraintName == constraint
+}
+
+// newBookingConflictError 排他制約違反からBookingConflictErrorを生成する
+// 重複先の予約IDは失敗したトランザクションの外で改めて取得する
+func newBookingConflictError(db *gorm.DB, startTime, endTime time.Time, excludeBookingID string) error {
+	ids, err := findOverlappingBookingIDs(db, startTime, endTime, excludeBookingID)
+	if err != nil {
+		return &BookingConflictError{}
+	}
+	return &BookingConflictError{ConflictingBookingIDs: ids}
+}
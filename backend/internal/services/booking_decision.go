@@ -0,0 +1,137 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrDecisionReasonRequired は承認・拒否の理由が指定されていないことを表す
+	ErrDecisionReasonRequired = NewValidationError("INVALID_PARAMETERS", "booking.decision_reason_required")
+	// ErrInvalidBookingAction は定義されていない操作が指定されたことを表す
+	ErrInvalidBookingAction = NewValidationError("INVALID_PARAMETERS", "booking.invalid_action")
+)
+
+// BookingAction は管理者が予約に対して行う操作
+type BookingAction string
+
+const (
+	// BookingActionApprove は承認待ちの予約の承認
+	BookingActionApprove BookingAction = "approve"
+	// BookingActionReject は承認待ちの予約の拒否
+	BookingActionReject BookingAction = "reject"
+	// BookingActionCancel は有効な予約のキャンセル
+	BookingActionCancel BookingAction = "cancel"
+)
+
+// BookingDecisionRequest は管理者による承認・拒否のリクエスト
+type BookingDecisionRequest struct {
+	// Reason は承認・拒否の理由（必須）。ステータスログに記録する
+	Reason string `json:"reason"`
+	// ConvertToConfirmed は承認と同時に仮予約を本予約に変更する（承認時のみ有効）
+	ConvertToConfirmed bool `json:"convertToConfirmed,omitempty"`
+}
+
+// validate 操作に必要な項目が指定されているかを検証する
+// 承認・拒否には理由が必須で、キャンセルの理由は任意
+func (r BookingDecisionRequest) validate(action BookingAction) error {
+	switch action {
+	case BookingActionApprove, BookingActionReject:
+		if strings.TrimSpace(r.Reason) == "" {
+			return ErrDecisionReasonRequired
+		}
+	case BookingActionCancel:
+	default:
+		return ErrInvalidBookingAction
+	}
+	return nil
+}
+
+// ApproveBooking 承認待ちの予約を承認する
+func (s *AdminBookingServiceImpl) ApproveBooking(bookingID string, req BookingDecisionRequest, adminID string) (*BookingResponse, error) {
+	return s.decideBooking(bookingID, BookingActionApprove, req, adminID)
+}
+
+// RejectBooking 承認待ちの予約を拒否する
+// 拒否した予約の時間枠は明け渡され、後ろのキープが繰り上がる
+func (s *AdminBookingServiceImpl) RejectBooking(bookingID string, req BookingDecisionRequest, adminID string) (*BookingResponse, error) {
+	return s.decideBooking(bookingID, BookingActionReject, req, adminID)
+}
+
+// decideBooking 予約1件に管理者の操作を適用し、更新後の予約を返す
+func (s *AdminBookingServiceImpl) decideBooking(bookingID string, action BookingAction, req BookingDecisionRequest, adminID string) (*BookingResponse, error) {
+	if err := req.validate(action); err != nil {
+		return nil, err
+	}
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("管理者IDが無効です: %w", err)
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		_, err := s.applyBookingAction(tx, bookingID, action, req, adminUUID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetBookingByID(bookingID)
+}
+
+// applyBookingAction 予約1件を行ロックし、操作に対応する状態遷移を行う
+// 呼び出し元のトランザクション内で実行する
+func (s *AdminBookingServiceImpl) applyBookingAction(tx *gorm.DB, bookingID string, action BookingAction, req BookingDecisionRequest, adminUUID uuid.UUID) (*BookingTransitionResult, error) {
+	booking, err := lockBooking(tx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	transition := BookingTransition{
+		Booking:   booking,
+		Actor:     BookingActorAdmin,
+		ChangedBy: &adminUUID,
+		Reason:    strings.TrimSpace(req.Reason),
+	}
+	switch action {
+	case BookingActionApprove:
+		transition.To.Status = models.BookingStatusApproved
+		transition.Note = "管理者による承認"
+		if req.ConvertToConfirmed && booking.BookingType == models.BookingTypeTemporary {
+			transition.To.Type = models.BookingTypeConfirmed
+			transition.Note = "管理者による承認（本予約に変更）"
+		}
+	case BookingActionReject:
+		transition.To.Status = models.BookingStatusRejected
+		transition.Note = "管理者による拒否"
+	case BookingActionCancel:
+		transition.To.Status = models.BookingStatusCancelled
+		transition.Note = "管理者によるキャンセル"
+	default:
+		return nil, ErrInvalidBookingAction
+	}
+
+	return s.states.Transition(tx, transition)
+}
+
+// lockBooking 状態を変更する予約を行ロックして取得する
+func lockBooking(tx *gorm.DB, bookingID string) (models.Booking, error) {
+	var booking models.Booking
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return booking, ErrBookingNotFound
+	}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&booking, "id = ?", bookingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return booking, ErrBookingNotFound
+		}
+		return booking, fmt.Errorf("予約の確認に失敗しました: %w", err)
+	}
+	return booking, nil
+}
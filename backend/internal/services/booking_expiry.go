@@ -0,0 +1,134 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/zebraApp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// temporaryBookingDeadlineDays は仮予約の確認期限（日数）
+const temporaryBookingDeadlineDays = 7
+
+// expiryBatchSize は1回の検索で処理する期限切れ仮予約の最大件数
+const expiryBatchSize = 100
+
+// errBookingNotExpired は処理中に予約が期限切れの対象外になったことを表す
+var errBookingNotExpired = errors.New("booking is no longer expired")
+
+// BookingExpiryServiceImpl は確認期限を過ぎた仮予約を自動キャンセルする
+type BookingExpiryServiceImpl struct {
+	db     *gorm.DB
+	states *BookingStateMachine
+}
+
+func NewBookingExpiryService(db *gorm.DB) *BookingExpiryServiceImpl {
+	return &BookingExpiryServiceImpl{
+		db: db,
+		// システムによるキャンセルではキャンセル料を算定しないため、料金設定は不要
+		states: NewBookingStateMachine(db, CancellationPolicy{}, nil),
+	}
+}
+
+// ExpireOverdueBookings 確認期限を過ぎた仮予約をキャンセルし、キャンセルした予約IDを返す
+// 予約ごとに個別のトランザクションで処理するため、途中で失敗してもそれまでの処理は確定する
+// キャンセルできなかった予約はログに記録して以降の検索から除外し、残りの処理を続ける。
+// 失敗した予約のエラーはまとめて返す
+func (s *BookingExpiryServiceImpl) ExpireOverdueBookings(now time.Time) ([]string, error) {
+	var expired, failed []string
+	var errs []error
+	for {
+		query := overdueTemporaryBookings(s.db, now)
+		if len(failed) > 0 {
+			query = query.Where("id NOT IN ?", failed)
+		}
+		var bookingIDs []string
+		if err := query.
+			Order("confirmation_deadline ASC").
+			Limit(expiryBatchSize).
+			Pluck("id", &bookingIDs).Error; err != nil {
+			errs = append(errs, fmt.Errorf("期限切れ仮予約の検索に失敗しました: %w", err))
+			return expired, errors.Join(errs...)
+		}
+
+		processed := 0
+		for _, bookingID := range bookingIDs {
+			err := s.expireBooking(bookingID, now)
+			if errors.Is(err, errBookingNotExpired) {
+				continue
+			}
+			processed++
+			if err != nil {
+				log.Printf("仮予約の自動キャンセルに失敗したためスキップします: %s: %v", bookingID, err)
+				failed = append(failed, bookingID)
+				errs = append(errs, fmt.Errorf("予約%sの自動キャンセルに失敗しました: %w", bookingID, err))
+				continue
+			}
+			expired = append(expired, bookingID)
+		}
+
+		// 全件処理済み、または他のプロセスが先に処理した場合は終了
+		if len(bookingIDs) < expiryBatchSize || processed == 0 {
+			return expired, errors.Join(errs...)
+		}
+	}
+}
+
+// expireBooking 期限切れ仮予約1件をキャンセルする
+func (s *BookingExpiryServiceImpl) expireBooking(bookingID string, now time.Time) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		// ロック取得後に条件を再確認する（ユーザーの確定操作と競合した場合に備える）
+		var booking models.Booking
+		err := overdueTemporaryBookings(tx, now).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&booking, "id = ?", bookingID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errBookingNotExpired
+		}
+		if err != nil {
+			return fmt.Errorf("予約の確認に失敗しました: %w", err)
+		}
+
+		// システムによる変更のため変更者は記録しない
+		// キープの繰り上げと繰り上がった予約への通知は状態遷移のフックで行う
+		if _, err := s.states.Transition(tx, BookingTransition{
+			Booking: booking,
+			To:      models.BookingState{Status: models.BookingStatusCancelled},
+			Actor:   BookingActorSystem,
+			Note:    "仮予約確認期限切れによる自動キャンセル",
+		}); err != nil {
+			return err
+		}
+
+		return notifyBookingUser(tx, booking, models.NotificationTypeBooking,
+			"仮予約が自動キャンセルされました",
+			fmt.Sprintf("%sの仮予約は確認期限（%s）を過ぎたため自動キャンセルされました。",
+				formatBookingPeriod(booking), booking.ConfirmationDeadline.Format("2006/01/02 15:04")))
+	})
+}
+
+// overdueTemporaryBookings 自動キャンセル対象の期限切れ仮予約を絞り込む
+func overdueTemporaryBookings(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Model(&models.Booking{}).
+		Where("booking_type = ? AND automatic_cancellation = ? AND status IN ? AND confirmation_deadline < ?",
+			models.BookingTypeTemporary, true, activeBookingStatuses, now)
+}
+
+// setConfirmationDeadline 仮予約に確認期限を設定し、期限切れで自動キャンセルされるようにする
+// 確認期限は作成からtemporaryBookingDeadlineDays日後とし、利用開始時刻を超えない。
+// 作成者が利用者・管理者のどちらでも、仮押さえが時間枠を占有し続けないよう同じ期限を設ける
+func setConfirmationDeadline(booking *models.Booking, now time.Time) {
+	if booking.BookingType != models.BookingTypeTemporary {
+		return
+	}
+	deadline := now.AddDate(0, 0, temporaryBookingDeadlineDays)
+	if deadline.After(booking.StartTime) {
+		deadline = booking.StartTime
+	}
+	booking.ConfirmationDeadline = &deadline
+	booking.AutomaticCancellation = true
+}
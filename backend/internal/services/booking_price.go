@@ -0,0 +1,68 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/pricing"
+	"gorm.io/gorm"
+)
+
+// snapshotBookingPrice 予約の料金内訳を計算し、予約にスナップショットとして保存する
+// 予約の時間帯とオプションを登録・変更した後、同じトランザクション内で呼び出す
+func snapshotBookingPrice(tx *gorm.DB, engine *pricing.Engine, bookingID uuid.UUID) error {
+	var booking models.Booking
+	if err := tx.Preload("BookingOptions.Option").
+		First(&booking, "id = ?", bookingID).Error; err != nil {
+		return fmt.Errorf("料金計算対象の予約の取得に失敗しました: %w", err)
+	}
+
+	options := make([]pricing.OptionLine, 0, len(booking.BookingOptions))
+	for _, bo := range booking.BookingOptions {
+		line := pricing.OptionLine{
+			OptionID: bo.OptionID,
+			Quantity: bo.Quantity,
+		}
+		if bo.Option != nil {
+			line.Name = bo.Option.Name
+			line.UnitPrice = bo.Option.UnitPrice
+			line.Unit = bo.Option.Unit
+		}
+		options = append(options, line)
+	}
+
+	breakdown, err := engine.Calculate(booking.StartTime, booking.EndTime, options)
+	if err != nil {
+		return err
+	}
+	if err := tx.Model(&models.Booking{}).Where("id = ?", bookingID).
+		Updates(map[string]interface{}{
+			"subtotal_amount":            breakdown.Subtotal,
+			"tax_amount":                 breakdown.TaxAmount,
+			"total_amount_including_tax": breakdown.Total,
+			"price_breakdown":            breakdown,
+		}).Error; err != nil {
+		return fmt.Errorf("料金の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// bookingUsageAmount キャンセル料の算定基礎となるスタジオ利用料（税抜）
+// 料金スナップショットがない予約は現在の料金設定で計算する
+func bookingUsageAmount(engine *pricing.Engine, booking models.Booking) (int, error) {
+	if booking.PriceBreakdown != nil {
+		return booking.PriceBreakdown.BaseAmount, nil
+	}
+	return engine.BaseAmount(booking.StartTime, booking.EndTime)
+}
+
+// quoteCancellation 予約の利用料を算定基礎としてキャンセル料を見積もる
+func quoteCancellation(policy CancellationPolicy, engine *pricing.Engine, booking models.Booking, cancelledAt time.Time) (CancellationQuote, error) {
+	baseAmount, err := bookingUsageAmount(engine, booking)
+	if err != nil {
+		return CancellationQuote{}, err
+	}
+	return policy.Quote(booking, baseAmount, cancelledAt), nil
+}
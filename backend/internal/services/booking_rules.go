@@ -0,0 +1,14 @@
+package services
+
+var (
+	// ErrNotTemporaryBooking は仮予約ではない予約を本予約に変更しようとしたことを表す
+	ErrNotTemporaryBooking = NewValidationError("INVALID_BOOKING_TYPE", "booking.not_temporary")
+	// ErrTemporaryBookingNotApproved は承認前の仮予約を本予約に変更しようとしたことを表す
+	ErrTemporaryBookingNotApproved = NewValidationError("BOOKING_CANNOT_BE_UPDATED", "booking.temporary_not_approved")
+	// ErrInvalidBookingTypeTransition は許可されていない予約タイプの変更であることを表す
+	ErrInvalidBookingTypeTransition = NewValidationError("INVALID_BOOKING_TYPE", "booking.invalid_type_transition")
+	// ErrConfirmationDeadlinePassed は仮予約の確認期限を過ぎていることを表す
+	ErrConfirmationDeadlinePassed = NewValidationError("BOOKING_CANNOT_BE_UPDATED", "booking.confirmation_deadline_passed")
+	// ErrNotFirstKeep は第一予約以外の仮予約を本予約に変更しようとしたことを表す
+	ErrNotFirstKeep = NewConflictError("BOOKING_CANNOT_BE_UPDATED", "booking.not_first_keep")
+)
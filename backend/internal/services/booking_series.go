@@ -0,0 +1,882 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/schedule"
+	"gorm.io/gorm"
+)
+
+const (
+	// seriesOccurrenceLimit は1つの定期予約で作成できる回の最大数
+	seriesOccurrenceLimit = 100
+	// seriesDateLayout は定期予約の日付（最終日・除外日・回の日付）の形式
+	seriesDateLayout = "2006-01-02"
+)
+
+var (
+	// ErrSeriesNotFound は定期予約が存在しないことを表す
+	ErrSeriesNotFound = NewNotFoundError("SERIES_NOT_FOUND", "booking_series.not_found")
+	// ErrInvalidSeriesFrequency は繰り返しの単位が正しくないことを表す
+	ErrInvalidSeriesFrequency = NewValidationError("INVALID_PARAMETERS", "booking_series.invalid_frequency")
+	// ErrInvalidSeriesInterval は繰り返しの間隔が正しくないことを表す
+	ErrInvalidSeriesInterval = NewValidationError("INVALID_PARAMETERS", "booking_series.invalid_interval")
+	// ErrSeriesEndRequired は回数・最終日のどちらも指定されていないことを表す
+	ErrSeriesEndRequired = NewValidationError("INVALID_PARAMETERS", "booking_series.end_required")
+	// ErrInvalidSeriesUntil は最終日の形式が正しくない、または初回より前であることを表す
+	ErrInvalidSeriesUntil = NewValidationError("INVALID_PARAMETERS", "booking_series.invalid_until")
+	// ErrInvalidSeriesException は除外日の形式が正しくないことを表す
+	ErrInvalidSeriesException = NewValidationError("INVALID_PARAMETERS", "booking_series.invalid_exception")
+	// ErrSeriesTooManyOccurrences は回の数が上限を超えることを表す
+	ErrSeriesTooManyOccurrences = NewValidationError("INVALID_PARAMETERS", "booking_series.too_many_occurrences", seriesOccurrenceLimit)
+	// ErrSeriesNoOccurrences は規則に該当する回がないことを表す
+	ErrSeriesNoOccurrences = NewValidationError("INVALID_PARAMETERS", "booking_series.no_occurrences")
+	// ErrInvalidSeriesScope は編集・キャンセルの対象範囲が正しくないことを表す
+	ErrInvalidSeriesScope = NewValidationError("INVALID_PARAMETERS", "booking_series.invalid_scope")
+	// ErrSeriesBookingRequired は起点とする回の予約が指定されていないことを表す
+	ErrSeriesBookingRequired = NewValidationError("INVALID_PARAMETERS", "booking_series.booking_required")
+	// ErrBookingNotInSeries は指定の予約が定期予約の回ではないことを表す
+	ErrBookingNotInSeries = NewValidationError("INVALID_PARAMETERS", "booking_series.booking_not_in_series")
+	// ErrSeriesNothingToUpdate は変更する項目が指定されていないことを表す
+	ErrSeriesNothingToUpdate = NewValidationError("INVALID_PARAMETERS", "booking_series.nothing_to_update")
+)
+
+var (
+	// errSeriesNotCreated はすべての回を作成できなかった場合にシリーズの作成を取り消すために内部でのみ使用する
+	errSeriesNotCreated = errors.New("no booking series occurrence created")
+	// errSeriesNotChanged はすべての回を編集・キャンセルできなかった場合に規則の変更を取り消すために内部でのみ使用する
+	errSeriesNotChanged = errors.New("no booking series occurrence changed")
+)
+
+// SeriesScope は定期予約の編集・キャンセルの対象範囲
+type SeriesScope string
+
+const (
+	// SeriesScopeThis は指定した回のみ
+	SeriesScopeThis SeriesScope = "this"
+	// SeriesScopeFollowing は指定した回以降
+	SeriesScopeFollowing SeriesScope = "following"
+	// SeriesScopeAll はすべての回
+	SeriesScopeAll SeriesScope = "all"
+)
+
+// CreateBookingSeries 定期予約の作成
+// 繰り返しの規則から各回の予約を作成し、重複や業務ルール違反で作成できなかった回を結果として返す。
+// すべての回を作成できなかった場合は定期予約も作成しない
+func (s *AdminBookingServiceImpl) CreateBookingSeries(req CreateBookingSeriesRequest, adminID string) (*BookingSeriesResult, error) {
+	// ユーザーの存在確認
+	if _, err := uuid.Parse(req.UserID); err != nil {
+		return nil, ErrUserNotFound
+	}
+	var user models.User
+	if err := s.db.First(&user, "id = ?", req.UserID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("ユーザーの確認に失敗しました: %w", err)
+	}
+
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("管理者IDが無効です: %w", err)
+	}
+
+	// 繰り返しの規則から各回の開始時刻を求める
+	rule, err := req.Recurrence.parse(req.StartTime, s.location)
+	if err != nil {
+		return nil, err
+	}
+	starts := rule.Occurrences(req.StartTime, s.location, seriesOccurrenceLimit+1)
+	if len(starts) == 0 {
+		return nil, ErrSeriesNoOccurrences
+	}
+	if len(starts) > seriesOccurrenceLimit {
+		return nil, ErrSeriesTooManyOccurrences
+	}
+
+	// ステータス・予約タイプの検証
+	status := models.BookingStatusPending
+	if req.Status != "" {
+		status = models.BookingStatus(req.Status)
+	}
+	bookingType := models.BookingType(req.BookingType)
+	if err := s.states.Check(models.Booking{}, models.BookingState{Status: status, Type: bookingType}, BookingActorAdmin, time.Now()); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	series := models.BookingSeries{
+		ID:             uuid.New(),
+		UserID:         &user.ID,
+		Frequency:      models.SeriesFrequency(rule.Frequency),
+		RepeatInterval: rule.Interval,
+		ExceptionDates: make(models.DateList, len(rule.Exceptions)),
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		BookingType:    bookingType,
+		Purpose:        req.Purpose,
+		CreatedBy:      &adminUUID,
+		UpdatedBy:      &adminUUID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if rule.Count > 0 {
+		count := rule.Count
+		series.OccurrenceCount = &count
+	}
+	if !rule.Until.IsZero() {
+		until := seriesDate(rule.Until, s.location)
+		series.UntilDate = &until
+	}
+	for i, e := range rule.Exceptions {
+		series.ExceptionDates[i] = e.Format(seriesDateLayout)
+	}
+
+	duration := req.EndTime.Sub(req.StartTime)
+	result := &BookingSeriesResult{Occurrences: make([]SeriesOccurrenceResult, 0, len(starts))}
+
+	// 回ごとにセーブポイントを置き、作成できなかった回があっても残りの回を作成する
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&series).Error; err != nil {
+			return fmt.Errorf("定期予約の作成に失敗しました: %w", err)
+		}
+
+		created := 0
+		for _, start := range starts {
+			occurrence := s.createSeriesOccurrence(tx, series, start, start.Add(duration), status, req.OptionIDs, req.OverrideRules, adminUUID)
+			if occurrence.Success {
+				created++
+			}
+			result.Occurrences = append(result.Occurrences, occurrence)
+		}
+		if created == 0 {
+			return errSeriesNotCreated
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errSeriesNotCreated) {
+		return nil, err
+	}
+	result.Committed = err == nil
+
+	if result.Committed {
+		if result.Series, err = s.GetBookingSeries(series.ID.String()); err != nil {
+			return nil, err
+		}
+	}
+	result.finish()
+	return result, nil
+}
+
+// createSeriesOccurrence 定期予約の1回分の予約を作成し、結果を返す
+// 重複・業務ルール違反の回は作成せず、理由を結果に記録する
+func (s *AdminBookingServiceImpl) createSeriesOccurrence(tx *gorm.DB, series models.BookingSeries, startTime, endTime time.Time, status models.BookingStatus, optionIDs []string, overrideRules bool, adminUUID uuid.UUID) SeriesOccurrenceResult {
+	occurrenceDate := seriesDate(startTime, s.location)
+	now := time.Now()
+	booking := models.Booking{
+		ID:             uuid.New(),
+		UserID:         series.UserID,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Status:         status,
+		BookingType:    series.BookingType,
+		Purpose:        series.Purpose,
+		SeriesID:       &series.ID,
+		OccurrenceDate: &occurrenceDate,
+		CreatedBy:      &adminUUID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	// 仮予約の回には作成時点からの確認期限を設定する
+	setConfirmationDeadline(&booking, now)
+
+	result := SeriesOccurrenceResult{
+		OccurrenceDate: occurrenceDate.Format(seriesDateLayout),
+		StartTime:      startTime,
+		EndTime:        endTime,
+	}
+
+	err := func() error {
+		// 業務ルールの検証（管理者が明示的に省略を指定した場合は時間の前後関係のみ）
+		// 仮予約数は作成済みの回を含めて数えるため、定期予約のトランザクションで検証する
+		if err := s.validator.ValidateAdminBooking(tx, booking, now, overrideRules); err != nil {
+			return err
+		}
+
+		return tx.Transaction(func(tx *gorm.DB) error {
+			if err := s.states.Create(tx, &booking, BookingActorAdmin, adminBookingNote("管理者による定期予約の作成", overrideRules)); err != nil {
+				return err
+			}
+			for _, optionIDStr := range optionIDs {
+				if err := createBookingOption(tx, booking.ID, SelectedOptionRequest{OptionID: optionIDStr, Quantity: 1}); err != nil {
+					return err
+				}
+			}
+			return snapshotBookingPrice(tx, s.pricing, booking.ID)
+		})
+	}()
+
+	// 排他制約違反はセーブポイントまで戻した後に重複先の予約を取得する
+	if isBookingOverlapViolation(err) {
+		err = newBookingConflictError(tx, startTime, endTime, "")
+	}
+	if err != nil {
+		result.setError(err)
+		return result
+	}
+
+	result.Success = true
+	result.BookingID = booking.ID.String()
+	result.Status = string(booking.Status)
+	return result
+}
+
+// GetBookingSeries 定期予約の詳細と各回の予約（回の日付順）
+func (s *AdminBookingServiceImpl) GetBookingSeries(seriesID string) (*BookingSeriesResponse, error) {
+	if _, err := uuid.Parse(seriesID); err != nil {
+		return nil, ErrSeriesNotFound
+	}
+
+	var series models.BookingSeries
+	if err := s.db.Preload("User").
+		Preload("Bookings", func(db *gorm.DB) *gorm.DB {
+			return db.Order("occurrence_date ASC, start_time ASC")
+		}).
+		Preload("Bookings.User").
+		Preload("Bookings.BookingOptions").
+		Preload("Bookings.BookingOptions.Option").
+		First(&series, "id = ?", seriesID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSeriesNotFound
+		}
+		return nil, fmt.Errorf("定期予約の取得に失敗しました: %w", err)
+	}
+
+	response := convertToSeriesResponse(series)
+	return &response, nil
+}
+
+// UpdateBookingSeries 定期予約の編集
+// 起点の回の変更後の日時と同じだけ、対象範囲の各回をずらす。
+// 「この回以降」では起点の回の前で定期予約を分割し、起点以降の回を新しい定期予約に移す
+func (s *AdminBookingServiceImpl) UpdateBookingSeries(seriesID string, req UpdateBookingSeriesRequest, adminID string) (*BookingSeriesResult, error) {
+	if req.StartTime == nil && req.EndTime == nil && req.Purpose == nil {
+		return nil, ErrSeriesNothingToUpdate
+	}
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("管理者IDが無効です: %w", err)
+	}
+
+	series, err := s.findSeries(seriesID)
+	if err != nil {
+		return nil, err
+	}
+	anchor, err := s.seriesAnchor(series, req.Scope, req.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := s.seriesTargets(series, req.Scope, anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	// 起点の回の変更量を対象の各回に適用する
+	var startShift, endShift time.Duration
+	if req.StartTime != nil {
+		startShift = req.StartTime.Sub(anchor.StartTime)
+	}
+	if req.EndTime != nil {
+		endShift = req.EndTime.Sub(anchor.EndTime)
+	}
+	if !anchor.EndTime.Add(endShift).After(anchor.StartTime.Add(startShift)) {
+		return nil, NewValidationError("INVALID_DATE_RANGE", "request.end_before_start")
+	}
+
+	// 規則の更新と各回の更新は1つのトランザクションで行う（この回のみの変更では定期予約は変えない）
+	// 各回はセーブポイントを置いて個別に更新し、重複などで更新できなかった回を結果として返す。
+	// すべての回を更新できなかった場合は規則の変更も取り消す。
+	// 分割した場合は、起点以降の回が移った新しい定期予約を結果として返す
+	resultSeriesID := series.ID
+	result := &BookingSeriesResult{
+		Scope:       req.Scope,
+		Occurrences: make([]SeriesOccurrenceResult, 0, len(targets)),
+	}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if req.Scope != SeriesScopeThis {
+			target := series
+			if req.Scope == SeriesScopeFollowing && !s.isFirstOccurrence(series, anchor) {
+				next, err := s.splitSeries(tx, series, anchor, adminUUID)
+				if err != nil {
+					return err
+				}
+				target = next
+			}
+
+			updates := map[string]interface{}{
+				"start_time": target.StartTime.Add(startShift),
+				"end_time":   target.EndTime.Add(endShift),
+				"updated_by": adminUUID,
+				"updated_at": time.Now(),
+			}
+			if req.Purpose != nil {
+				updates["purpose"] = *req.Purpose
+			}
+			if err := tx.Model(&target).Updates(updates).Error; err != nil {
+				return fmt.Errorf("定期予約の更新に失敗しました: %w", err)
+			}
+			resultSeriesID = target.ID
+		}
+
+		updated := 0
+		for _, b := range targets {
+			update := UpdateBookingRequest{Purpose: req.Purpose, OverrideRules: req.OverrideRules}
+			if startShift != 0 || endShift != 0 {
+				startTime, endTime := b.StartTime.Add(startShift), b.EndTime.Add(endShift)
+				update.StartTime, update.EndTime = &startTime, &endTime
+			}
+
+			occurrence := newSeriesOccurrenceResult(b)
+			if update.StartTime != nil {
+				occurrence.StartTime, occurrence.EndTime = *update.StartTime, *update.EndTime
+			}
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				return s.updateBooking(tx, b.ID.String(), update, adminUUID)
+			})
+			if err != nil {
+				occurrence.setError(err)
+			} else {
+				occurrence.Success = true
+				occurrence.Status = string(b.Status)
+				updated++
+			}
+			result.Occurrences = append(result.Occurrences, occurrence)
+		}
+		if len(targets) > 0 && updated == 0 {
+			return errSeriesNotChanged
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errSeriesNotChanged) {
+		return nil, err
+	}
+	result.Committed = err == nil
+	if !result.Committed {
+		resultSeriesID = series.ID
+	}
+
+	if result.Series, err = s.GetBookingSeries(resultSeriesID.String()); err != nil {
+		return nil, err
+	}
+	result.finish()
+	return result, nil
+}
+
+// CancelBookingSeries 定期予約のキャンセル
+// 「この回のみ」は回の日付を除外日に加え、「この回以降」は起点の回の前日で定期予約を終了する
+func (s *AdminBookingServiceImpl) CancelBookingSeries(seriesID string, req CancelBookingSeriesRequest, adminID string) (*BookingSeriesResult, error) {
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, fmt.Errorf("管理者IDが無効です: %w", err)
+	}
+
+	series, err := s.findSeries(seriesID)
+	if err != nil {
+		return nil, err
+	}
+	anchor, err := s.seriesAnchor(series, req.Scope, req.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := s.seriesTargets(series, req.Scope, anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	// 規則の更新
+	now := time.Now()
+	updates := map[string]interface{}{
+		"updated_by": adminUUID,
+		"updated_at": now,
+	}
+	switch {
+	case req.Scope == SeriesScopeThis:
+		date := anchor.OccurrenceDate.Format(seriesDateLayout)
+		exceptions := series.ExceptionDates
+		if !containsDate(exceptions, date) {
+			exceptions = append(exceptions, date)
+		}
+		updates["exception_dates"] = exceptions
+	case req.Scope == SeriesScopeFollowing && !s.isFirstOccurrence(series, anchor):
+		until, count := s.seriesBoundary(series, anchor)
+		updates["until_date"] = until
+		updates["occurrence_count"] = count
+		updates["exception_dates"] = datesBefore(series.ExceptionDates, anchor)
+	default:
+		updates["cancelled_at"] = now
+	}
+
+	// 規則の更新と各回のキャンセルは1つのトランザクションで行う
+	// 各回はセーブポイントを置いて個別にキャンセルし、キャンセルできなかった回を結果として返す。
+	// すべての回をキャンセルできなかった場合は規則の変更も取り消す
+	result := &BookingSeriesResult{
+		Scope:       req.Scope,
+		Occurrences: make([]SeriesOccurrenceResult, 0, len(targets)),
+	}
+	decision := BookingDecisionRequest{Reason: req.Reason}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&series).Updates(updates).Error; err != nil {
+			return fmt.Errorf("定期予約の更新に失敗しました: %w", err)
+		}
+
+		cancelled := 0
+		for _, b := range targets {
+			occurrence := newSeriesOccurrenceResult(b)
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				transition, err := s.applyBookingAction(tx, b.ID.String(), BookingActionCancel, decision, adminUUID)
+				if err != nil {
+					return err
+				}
+				occurrence.Status = string(transition.To.Status)
+				return nil
+			})
+			if err != nil {
+				occurrence.setError(err)
+			} else {
+				occurrence.Success = true
+				cancelled++
+			}
+			result.Occurrences = append(result.Occurrences, occurrence)
+		}
+		if len(targets) > 0 && cancelled == 0 {
+			return errSeriesNotChanged
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errSeriesNotChanged) {
+		return nil, err
+	}
+	result.Committed = err == nil
+
+	if result.Series, err = s.GetBookingSeries(series.ID.String()); err != nil {
+		return nil, err
+	}
+	result.finish()
+	return result, nil
+}
+
+// findSeries 定期予約を取得する
+func (s *AdminBookingServiceImpl) findSeries(seriesID string) (models.BookingSeries, error) {
+	var series models.BookingSeries
+	if _, err := uuid.Parse(seriesID); err != nil {
+		return series, ErrSeriesNotFound
+	}
+	if err := s.db.First(&series, "id = ?", seriesID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return series, ErrSeriesNotFound
+		}
+		return series, fmt.Errorf("定期予約の取得に失敗しました: %w", err)
+	}
+	return series, nil
+}
+
+// seriesAnchor 編集・キャンセルの起点とする回の予約を取得する
+// 「すべての回」で予約を省略した場合は、最初の有効な回（なければ最初の回）を起点とする
+func (s *AdminBookingServiceImpl) seriesAnchor(series models.BookingSeries, scope SeriesScope, bookingID string) (models.Booking, error) {
+	var anchor models.Booking
+	switch scope {
+	case SeriesScopeThis, SeriesScopeFollowing, SeriesScopeAll:
+	default:
+		return anchor, ErrInvalidSeriesScope
+	}
+
+	if bookingID == "" {
+		if scope != SeriesScopeAll {
+			return anchor, ErrSeriesBookingRequired
+		}
+		err := s.db.Where("series_id = ?", series.ID).
+			Order(gorm.Expr("CASE WHEN status IN ? THEN 0 ELSE 1 END, occurrence_date ASC", activeBookingStatuses)).
+			First(&anchor).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return anchor, ErrBookingNotFound
+		}
+		if err != nil {
+			return anchor, fmt.Errorf("予約の確認に失敗しました: %w", err)
+		}
+		return anchor, nil
+	}
+
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return anchor, ErrBookingNotFound
+	}
+	if err := s.db.First(&anchor, "id = ?", bookingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return anchor, ErrBookingNotFound
+		}
+		return anchor, fmt.Errorf("予約の確認に失敗しました: %w", err)
+	}
+	if anchor.SeriesID == nil || *anchor.SeriesID != series.ID || anchor.OccurrenceDate == nil {
+		return anchor, ErrBookingNotInSeries
+	}
+	return anchor, nil
+}
+
+// seriesTargets 対象範囲の回の予約を回の日付順に取得する
+// 「この回のみ」以外では、時間枠を占有している回のみを対象とする
+func (s *AdminBookingServiceImpl) seriesTargets(series models.BookingSeries, scope SeriesScope, anchor models.Booking) ([]models.Booking, error) {
+	if scope == SeriesScopeThis {
+		return []models.Booking{anchor}, nil
+	}
+
+	query := s.db.Where("series_id = ? AND status IN ?", series.ID, activeBookingStatuses)
+	if scope == SeriesScopeFollowing {
+		query = query.Where("occurrence_date >= ?", anchor.OccurrenceDate.Format(seriesDateLayout))
+	}
+
+	var bookings []models.Booking
+	if err := query.Order("occurrence_date ASC, start_time ASC").Find(&bookings).Error; err != nil {
+		return nil, fmt.Errorf("定期予約の回の取得に失敗しました: %w", err)
+	}
+	return bookings, nil
+}
+
+// isFirstOccurrence 起点の回が定期予約の最初の回かどうか
+func (s *AdminBookingServiceImpl) isFirstOccurrence(series models.BookingSeries, anchor models.Booking) bool {
+	return anchor.OccurrenceDate.Format(seriesDateLayout) == series.StartTime.In(s.location).Format(seriesDateLayout)
+}
+
+// seriesBoundary 定期予約を起点の回の前日で終了する場合の最終日と回数
+// 回数の指定がない定期予約では回数はnilのまま
+func (s *AdminBookingServiceImpl) seriesBoundary(series models.BookingSeries, anchor models.Booking) (time.Time, *int) {
+	y, m, d := anchor.OccurrenceDate.Date()
+	until := time.Date(y, m, d-1, 0, 0, 0, 0, time.UTC)
+	if series.OccurrenceCount == nil {
+		return until, nil
+	}
+
+	// 回数には除外日も含めるため、除外日を除かずに数える
+	rule := s.seriesRecurrence(series)
+	rule.Exceptions = nil
+	rule.Until = time.Date(y, m, d-1, 0, 0, 0, 0, s.location)
+	count := len(rule.Occurrences(series.StartTime, s.location, *series.OccurrenceCount))
+	return until, &count
+}
+
+// splitSeries 定期予約を起点の回の前で分割し、起点以降の回を新しい定期予約に移す
+// 元の定期予約は起点の前日で終了し、回数の指定がある場合は残りの回数を新しい定期予約に引き継ぐ
+func (s *AdminBookingServiceImpl) splitSeries(tx *gorm.DB, series models.BookingSeries, anchor models.Booking, adminUUID uuid.UUID) (models.BookingSeries, error) {
+	until, count := s.seriesBoundary(series, anchor)
+
+	// 新しい定期予約の初回は、起点の回の規則上の日時
+	y, m, d := anchor.OccurrenceDate.Date()
+	hour, min, sec := series.StartTime.In(s.location).Clock()
+	startTime := time.Date(y, m, d, hour, min, sec, 0, s.location)
+
+	now := time.Now()
+	next := series
+	next.ID = uuid.New()
+	next.StartTime = startTime
+	next.EndTime = startTime.Add(series.EndTime.Sub(series.StartTime))
+	next.ExceptionDates = datesFrom(series.ExceptionDates, anchor)
+	next.CreatedBy = &adminUUID
+	next.UpdatedBy = &adminUUID
+	next.CreatedAt = now
+	next.UpdatedAt = now
+	if count != nil {
+		remaining := *series.OccurrenceCount - *count
+		next.OccurrenceCount = &remaining
+	}
+	if err := tx.Create(&next).Error; err != nil {
+		return next, fmt.Errorf("定期予約の作成に失敗しました: %w", err)
+	}
+
+	if err := tx.Model(&series).Updates(map[string]interface{}{
+		"until_date":       until,
+		"occurrence_count": count,
+		"exception_dates":  datesBefore(series.ExceptionDates, anchor),
+		"updated_by":       adminUUID,
+		"updated_at":       now,
+	}).Error; err != nil {
+		return next, fmt.Errorf("定期予約の更新に失敗しました: %w", err)
+	}
+
+	if err := tx.Model(&models.Booking{}).
+		Where("series_id = ? AND occurrence_date >= ?", series.ID, anchor.OccurrenceDate.Format(seriesDateLayout)).
+		Update("series_id", next.ID).Error; err != nil {
+		return next, fmt.Errorf("定期予約の回の移動に失敗しました: %w", err)
+	}
+	return next, nil
+}
+
+// seriesRecurrence 定期予約に保存された繰り返しの規則
+// DATE型の列の日付はスタジオのタイムゾーンの日付として解釈する
+func (s *AdminBookingServiceImpl) seriesRecurrence(series models.BookingSeries) schedule.Recurrence {
+	rule := schedule.Recurrence{
+		Frequency: schedule.Frequency(series.Frequency),
+		Interval:  series.RepeatInterval,
+	}
+	if series.OccurrenceCount != nil {
+		rule.Count = *series.OccurrenceCount
+	}
+	if series.UntilDate != nil {
+		y, m, d := series.UntilDate.Date()
+		rule.Until = time.Date(y, m, d, 0, 0, 0, 0, s.location)
+	}
+	for _, e := range series.ExceptionDates {
+		if date, err := time.ParseInLocation(seriesDateLayout, e, s.location); err == nil {
+			rule.Exceptions = append(rule.Exceptions, date)
+		}
+	}
+	return rule
+}
+
+// parse 繰り返しの規則を検証し、日付をスタジオのタイムゾーンで解釈する
+func (r RecurrenceRequest) parse(first time.Time, loc *time.Location) (schedule.Recurrence, error) {
+	rule := schedule.Recurrence{
+		Frequency: schedule.Frequency(r.Frequency),
+		Interval:  r.Interval,
+		Count:     r.Count,
+	}
+	if !rule.Frequency.Valid() {
+		return rule, ErrInvalidSeriesFrequency
+	}
+	if rule.Interval == 0 {
+		rule.Interval = 1
+	}
+	if rule.Interval < 1 {
+		return rule, ErrInvalidSeriesInterval
+	}
+	if r.Count < 0 || (r.Count == 0 && r.Until == "") {
+		return rule, ErrSeriesEndRequired
+	}
+	if r.Count > seriesOccurrenceLimit {
+		return rule, ErrSeriesTooManyOccurrences
+	}
+
+	if r.Until != "" {
+		until, err := time.ParseInLocation(seriesDateLayout, r.Until, loc)
+		if err != nil || until.Format(seriesDateLayout) < first.In(loc).Format(seriesDateLayout) {
+			return rule, ErrInvalidSeriesUntil
+		}
+		rule.Until = until
+	}
+
+	for _, e := range r.Exceptions {
+		date, err := time.ParseInLocation(seriesDateLayout, e, loc)
+		if err != nil {
+			return rule, ErrInvalidSeriesException.WithDetails(map[string]interface{}{"date": e})
+		}
+		rule.Exceptions = append(rule.Exceptions, date)
+	}
+	return rule, nil
+}
+
+// seriesDate スタジオのタイムゾーンでの日付を、DATE型の列に保存する値に変換する
+func seriesDate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// containsDate 日付の一覧に指定の日付が含まれるかどうか
+func containsDate(dates models.DateList, date string) bool {
+	for _, d := range dates {
+		if d == date {
+			return true
+		}
+	}
+	return false
+}
+
+// datesBefore 起点の回の日付より前の日付
+func datesBefore(dates models.DateList, anchor models.Booking) models.DateList {
+	boundary := anchor.OccurrenceDate.Format(seriesDateLayout)
+	before := models.DateList{}
+	for _, d := range dates {
+		if d < boundary {
+			before = append(before, d)
+		}
+	}
+	return before
+}
+
+// datesFrom 起点の回の日付以降の日付
+func datesFrom(dates models.DateList, anchor models.Booking) models.DateList {
+	boundary := anchor.OccurrenceDate.Format(seriesDateLayout)
+	from := models.DateList{}
+	for _, d := range dates {
+		if d >= boundary {
+			from = append(from, d)
+		}
+	}
+	return from
+}
+
+// newSeriesOccurrenceResult 既存の回の予約の処理結果を初期化する
+func newSeriesOccurrenceResult(booking models.Booking) SeriesOccurrenceResult {
+	result := SeriesOccurrenceResult{
+		BookingID: booking.ID.String(),
+		StartTime: booking.StartTime,
+		EndTime:   booking.EndTime,
+	}
+	if booking.OccurrenceDate != nil {
+		result.OccurrenceDate = booking.OccurrenceDate.Format(seriesDateLayout)
+	}
+	return result
+}
+
+// setError 処理できなかった理由を記録する
+func (r *SeriesOccurrenceResult) setError(err error) {
+	r.Success = false
+	r.Err = err
+	var conflict *BookingConflictError
+	if errors.As(err, &conflict) {
+		r.ConflictingBookingIDs = conflict.ConflictingBookingIDs
+	}
+}
+
+// finish 件数を集計する
+func (r *BookingSeriesResult) finish() {
+	r.TotalProcessed = len(r.Occurrences)
+	for _, o := range r.Occurrences {
+		if o.Success {
+			r.SuccessCount++
+		} else {
+			r.FailureCount++
+		}
+	}
+	r.ProcessedAt = time.Now()
+}
+
+// convertToSeriesResponse モデルをレスポンス形式に変換
+func convertToSeriesResponse(series models.BookingSeries) BookingSeriesResponse {
+	response := BookingSeriesResponse{
+		ID:          series.ID.String(),
+		Frequency:   string(series.Frequency),
+		Interval:    series.RepeatInterval,
+		Count:       series.OccurrenceCount,
+		Exceptions:  series.ExceptionDates,
+		StartTime:   series.StartTime,
+		EndTime:     series.EndTime,
+		BookingType: string(series.BookingType),
+		Purpose:     series.Purpose,
+		CancelledAt: series.CancelledAt,
+		CreatedAt:   series.CreatedAt,
+		UpdatedAt:   series.UpdatedAt,
+		Bookings:    make([]BookingResponse, len(series.Bookings)),
+	}
+	if response.Exceptions == nil {
+		response.Exceptions = models.DateList{}
+	}
+	if series.UserID != nil {
+		response.UserID = series.UserID.String()
+	}
+	if series.User != nil {
+		response.UserName = series.User.FullName
+	}
+	if series.UntilDate != nil {
+		response.Until = series.UntilDate.Format(seriesDateLayout)
+	}
+	for i, b := range series.Bookings {
+		response.Bookings[i] = convertToBookingResponse(b)
+	}
+	return response
+}
+
+// コントローラーと共有するリクエスト・レスポンス型
+
+// RecurrenceRequest は繰り返しの規則（RRULEのFREQ・INTERVAL・COUNT・UNTIL・EXDATEに相当）
+type RecurrenceRequest struct {
+	Frequency string `json:"frequency"`          // daily・weekly・monthly
+	Interval  int    `json:"interval,omitempty"` // 既定は1
+	// Count・Untilの少なくとも一方が必要。Countには除外日も含める
+	Count      int      `json:"count,omitempty"`
+	Until      string   `json:"until,omitempty"`      // YYYY-MM-DD（この日までに始まる回を含む）
+	Exceptions []string `json:"exceptions,omitempty"` // 除外する日付（YYYY-MM-DD）
+}
+
+type CreateBookingSeriesRequest struct {
+	UserID string `json:"userId"`
+	// StartTime・EndTime は初回の日時。各回は同じ時刻・同じ長さで繰り返す
+	StartTime   time.Time         `json:"startTime"`
+	EndTime     time.Time         `json:"endTime"`
+	BookingType string            `json:"bookingType"`
+	Purpose     string            `json:"purpose,omitempty"`
+	OptionIDs   []string          `json:"optionIds,omitempty"`
+	Status      string            `json:"status,omitempty"`
+	Recurrence  RecurrenceRequest `json:"recurrence"`
+	// OverrideRules は各回の業務ルールの検証を省略する
+	OverrideRules bool `json:"overrideRules,omitempty"`
+}
+
+type UpdateBookingSeriesRequest struct {
+	Scope SeriesScope `json:"scope"`
+	// BookingID は起点とする回の予約（「すべての回」では省略可）
+	BookingID string `json:"bookingId,omitempty"`
+	// StartTime・EndTime は起点の回の変更後の日時。対象の各回を起点と同じだけずらす
+	StartTime *time.Time `json:"startTime,omitempty"`
+	EndTime   *time.Time `json:"endTime,omitempty"`
+	Purpose   *string    `json:"purpose,omitempty"`
+	// OverrideRules は時間変更時の業務ルールの検証を省略する
+	OverrideRules bool `json:"overrideRules,omitempty"`
+}
+
+type CancelBookingSeriesRequest struct {
+	Scope SeriesScope `json:"scope"`
+	// BookingID は起点とする回の予約（「すべての回」では省略可）
+	BookingID string `json:"bookingId,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// SeriesOccurrenceResult は定期予約の回ごとの処理結果
+type SeriesOccurrenceResult struct {
+	OccurrenceDate string    `json:"occurrenceDate"`
+	BookingID      string    `json:"bookingId,omitempty"`
+	StartTime      time.Time `json:"startTime"`
+	EndTime        time.Time `json:"endTime"`
+	Success        bool      `json:"success"`
+	// Status は処理後のステータス（成功した場合のみ）
+	Status string `json:"status,omitempty"`
+	// ConflictingBookingIDs は時間帯が重なったため処理できなかった場合の重複先の予約
+	ConflictingBookingIDs []string `json:"conflictingBookingIds,omitempty"`
+	// Code・Messageは失敗理由。Errからレスポンスの言語で設定する
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Err     error  `json:"-"`
+}
+
+// BookingSeriesResult は定期予約の作成・編集・キャンセルの結果
+type BookingSeriesResult struct {
+	Series *BookingSeriesResponse `json:"series,omitempty"`
+	Scope  SeriesScope            `json:"scope,omitempty"`
+	// Committed は変更が確定したかどうか（すべての回を作成・編集・キャンセルできなかった場合はfalse）
+	Committed      bool                     `json:"committed"`
+	TotalProcessed int                      `json:"totalProcessed"`
+	SuccessCount   int                      `json:"successCount"`
+	FailureCount   int                      `json:"failureCount"`
+	Occurrences    []SeriesOccurrenceResult `json:"occurrences"`
+	ProcessedAt    time.Time                `json:"processedAt"`
+}
+
+type BookingSeriesResponse struct {
+	ID          string            `json:"id"`
+	UserID      string            `json:"userId"`
+	UserName    string            `json:"userName"`
+	Frequency   string            `json:"frequency"`
+	Interval    int               `json:"interval"`
+	Count       *int              `json:"count,omitempty"`
+	Until       string            `json:"until,omitempty"`
+	Exceptions  models.DateList   `json:"exceptions"`
+	StartTime   time.Time         `json:"startTime"`
+	EndTime     time.Time         `json:"endTime"`
+	BookingType string            `json:"bookingType"`
+	Purpose     string            `json:"purpose"`
+	CancelledAt *time.Time        `json:"cancelledAt,omitempty"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+	Bookings    []BookingResponse `json:"bookings"`
+}
@@ -0,0 +1,412 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/pricing"
+	"gorm.io/gorm"
+)
+
+// BookingActor は予約の状態を変更する主体
+type BookingActor string
+
+const (
+	// BookingActorUser は予約したユーザー本人
+	BookingActorUser BookingActor = "user"
+	// BookingActorAdmin は管理者
+	BookingActorAdmin BookingActor = "admin"
+	// BookingActorSystem は期限切れ処理などのシステム処理
+	BookingActorSystem BookingActor = "system"
+)
+
+var (
+	// ErrInvalidBookingStatus は定義されていないステータスが指定されたことを表す
+	ErrInvalidBookingStatus = NewValidationError("INVALID_STATUS", "booking.invalid_status")
+	// ErrInvalidBookingType は定義されていない予約タイプが指定されたことを表す
+	ErrInvalidBookingType = NewValidationError("INVALID_BOOKING_TYPE", "booking.invalid_type")
+	// ErrInvalidStatusTransition は許可されていないステータスの変更であることを表す
+	ErrInvalidStatusTransition = NewValidationError("BOOKING_CANNOT_BE_UPDATED", "booking.invalid_status_transition")
+	// ErrBookingNotApprovable は承認待ち以外の予約を承認しようとしたことを表す
+	ErrBookingNotApprovable = NewValidationError("BOOKING_CANNOT_BE_UPDATED", "booking.not_approvable")
+	// ErrBookingNotRejectable は承認待ち以外の予約を拒否しようとしたことを表す
+	ErrBookingNotRejectable = NewValidationError("BOOKING_CANNOT_BE_UPDATED", "booking.not_rejectable")
+)
+
+// BookingTransition は既存の予約1件に対する状態遷移の要求
+type BookingTransition struct {
+	// Booking はロック済みの遷移前の予約
+	Booking models.Booking
+	// To は遷移先の状態。空の項目は遷移前の値を引き継ぐ
+	To        models.BookingState
+	Actor     BookingActor
+	ChangedBy *uuid.UUID
+	// Note はステータスログに記録する内容
+	Note string
+	// Reason は操作の理由。ステータスログに追記し、拒否の場合は顧客への通知にも含める
+	Reason string
+	// Updates は状態と同時に更新する列（時間帯・キープ順序など）
+	Updates map[string]interface{}
+	// SlotChanged は時間帯を変更することを表す
+	// 元の時間枠を明け渡して後ろのキープを繰り上げる。新しいキープ順序は呼び出し元が決める
+	SlotChanged bool
+	// Record は状態が変わらない場合もステータスログを記録する（変更申請の承認など）
+	Record bool
+}
+
+// BookingTransitionResult は状態遷移の結果
+type BookingTransitionResult struct {
+	From models.BookingState
+	To   models.BookingState
+	// Quote はキャンセル時に算定したキャンセル料
+	Quote *CancellationQuote
+	// Promoted は繰り上がった後ろのキープ
+	Promoted []models.Booking
+}
+
+// transitionContext は状態遷移1回分の処理中の情報
+type transitionContext struct {
+	t        *BookingTransition
+	from, to models.BookingState
+	now      time.Time
+	updates  map[string]interface{}
+	// notes はフックがステータスログに追記する内容
+	notes  []string
+	result *BookingTransitionResult
+}
+
+// bookingTransitionRule は状態遷移に付随するガード条件と副作用
+type bookingTransitionRule struct {
+	applies func(c *transitionContext) bool
+	// guard はデータベースを参照しない事前条件
+	guard func(c *transitionContext) error
+	// before は予約の更新前に実行し、更新内容の追加やデータベース上の事前条件の確認を行う
+	before func(tx *gorm.DB, c *transitionContext) error
+	// after は予約の更新後に実行する副作用
+	after func(tx *gorm.DB, c *transitionContext) error
+}
+
+// BookingStateMachine は予約のステータスと予約タイプの遷移を一元的に扱う
+// 遷移の可否はmodelsの遷移表とガード条件で判定し、遷移に付随する処理はフックとして実行する
+type BookingStateMachine struct {
+	keepQueue          *KeepQueueServiceImpl
+	cancellationPolicy CancellationPolicy
+	pricing            *pricing.Engine
+	rules              []bookingTransitionRule
+}
+
+func NewBookingStateMachine(db *gorm.DB, cancellationPolicy CancellationPolicy, pricingEngine *pricing.Engine) *BookingStateMachine {
+	m := &BookingStateMachine{
+		keepQueue:          NewKeepQueueService(db),
+		cancellationPolicy: cancellationPolicy,
+		pricing:            pricingEngine,
+	}
+	m.rules = m.transitionRules()
+	return m
+}
+
+// transitionRules 遷移ごとのガード条件とフック（上から順に実行する）
+func (m *BookingStateMachine) transitionRules() []bookingTransitionRule {
+	return []bookingTransitionRule{
+		// 仮予約から本予約への変更: 確認期限内の第一予約に限り、自動キャンセルを解除する
+		{
+			applies: func(c *transitionContext) bool {
+				return c.from.Type == models.BookingTypeTemporary && c.to.Type == models.BookingTypeConfirmed
+			},
+			guard: func(c *transitionContext) error {
+				deadline := c.t.Booking.ConfirmationDeadline
+				if c.t.Actor == BookingActorUser && deadline != nil && c.now.After(*deadline) {
+					return ErrConfirmationDeadlinePassed
+				}
+				return nil
+			},
+			before: func(tx *gorm.DB, c *transitionContext) error {
+				// 時間帯を変更する場合は呼び出し元が新しい時間帯でキープ順序を決め直している
+				if !c.t.SlotChanged {
+					if err := m.keepQueue.EnsureFirstKeep(tx, c.t.Booking); err != nil {
+						return err
+					}
+				}
+				c.updates["confirmation_deadline"] = nil
+				c.updates["automatic_cancellation"] = false
+				return nil
+			},
+		},
+		// 承認: 承認者を記録し、顧客に通知する
+		{
+			applies: func(c *transitionContext) bool {
+				return c.from.Status != models.BookingStatusApproved && c.to.Status == models.BookingStatusApproved
+			},
+			before: func(tx *gorm.DB, c *transitionContext) error {
+				if c.t.ChangedBy != nil {
+					c.updates["approved_by"] = *c.t.ChangedBy
+				}
+				c.updates["approved_at"] = c.now
+				return nil
+			},
+			after: func(tx *gorm.DB, c *transitionContext) error {
+				return notifyBookingUser(tx, c.t.Booking, models.NotificationTypeBooking,
+					"予約が承認されました",
+					fmt.Sprintf("%sの予約が承認されました。", formatBookingPeriod(c.t.Booking)))
+			},
+		},
+		// 拒否: 理由を添えて顧客に通知する
+		{
+			applies: func(c *transitionContext) bool {
+				return c.from.Status != models.BookingStatusRejected && c.to.Status == models.BookingStatusRejected
+			},
+			after: func(tx *gorm.DB, c *transitionContext) error {
+				content := fmt.Sprintf("%sの予約は承認されませんでした。", formatBookingPeriod(c.t.Booking))
+				if c.t.Reason != "" {
+					content += "\n理由: " + c.t.Reason
+				}
+				return notifyBookingUser(tx, c.t.Booking, models.NotificationTypeBooking,
+					"予約が承認されませんでした", content)
+			},
+		},
+		// キャンセル: ユーザー・管理者によるキャンセルはキャンセル料を記録する
+		{
+			applies: func(c *transitionContext) bool {
+				return c.from.Status.IsActive() && c.to.Status == models.BookingStatusCancelled
+			},
+			before: func(tx *gorm.DB, c *transitionContext) error {
+				if c.t.Actor == BookingActorSystem {
+					return nil
+				}
+				quote, err := quoteCancellation(m.cancellationPolicy, m.pricing, c.t.Booking, c.now)
+				if err != nil {
+					return err
+				}
+				c.updates["cancellation_fee_percent"] = quote.FeePercent
+				c.updates["cancellation_fee_amount"] = quote.FeeAmount
+				c.notes = append(c.notes, fmt.Sprintf("（キャンセル料: %g%% / %d円）", quote.FeePercent, quote.FeeAmount))
+				c.result.Quote = &quote
+				return nil
+			},
+		},
+		// 予約の終了: 申請中の変更申請を取り下げ、希望の時間帯の仮押さえを解除する
+		{
+			applies: func(c *transitionContext) bool {
+				return c.from.Status.IsActive() && !c.to.Status.IsActive()
+			},
+			after: func(tx *gorm.DB, c *transitionContext) error {
+				return cancelPendingChangeRequests(tx, c.t.Booking.ID, c.now)
+			},
+		},
+		// 時間枠の明け渡し: 後ろのキープを繰り上げ、繰り上がった予約のユーザーに通知する
+		{
+			applies: func(c *transitionContext) bool {
+				return c.from.Status.IsActive() && (!c.to.Status.IsActive() || c.t.SlotChanged)
+			},
+			after: func(tx *gorm.DB, c *transitionContext) error {
+				promoted, err := m.keepQueue.PromoteAfterRelease(tx, c.t.Booking, c.t.ChangedBy)
+				if err != nil {
+					return err
+				}
+				for _, b := range promoted {
+					if err := notifyBookingUser(tx, b, models.NotificationTypeBooking,
+						"キープが繰り上がりました",
+						fmt.Sprintf("%sの予約が%sに繰り上がりました。", formatBookingPeriod(b), keepOrderLabel(b.KeepOrder))); err != nil {
+						return err
+					}
+				}
+				c.result.Promoted = promoted
+				return nil
+			},
+		},
+	}
+}
+
+// newContext 遷移要求から処理中の情報を作成する
+func (m *BookingStateMachine) newContext(t *BookingTransition, now time.Time) *transitionContext {
+	from := t.Booking.State()
+	to := t.To
+	if to.Status == "" {
+		to.Status = from.Status
+	}
+	if to.Type == "" {
+		to.Type = from.Type
+	}
+
+	updates := make(map[string]interface{}, len(t.Updates)+4)
+	for k, v := range t.Updates {
+		updates[k] = v
+	}
+
+	return &transitionContext{
+		t:       t,
+		from:    from,
+		to:      to,
+		now:     now,
+		updates: updates,
+		result:  &BookingTransitionResult{From: from, To: to},
+	}
+}
+
+// Check 予約を指定の状態に遷移できるかどうかを検証する（データベースは参照しない）
+// bookingにゼロ値の状態を渡した場合は新規作成として検証する
+func (m *BookingStateMachine) Check(booking models.Booking, to models.BookingState, actor BookingActor, now time.Time) error {
+	return m.check(m.newContext(&BookingTransition{Booking: booking, To: to, Actor: actor}, now))
+}
+
+func (m *BookingStateMachine) check(c *transitionContext) error {
+	if !c.to.Status.Valid() {
+		return ErrInvalidBookingStatus
+	}
+	if !c.to.Type.Valid() {
+		return ErrInvalidBookingType
+	}
+	// ステータスを指定しない遷移は項目のみの更新（時間帯の変更・変更申請の承認など）として扱う
+	// 指定した場合は現在と同じステータスであっても遷移表で検証し、承認・拒否・キャンセルの繰り返しを拒否する
+	if c.t.To.Status != "" && !c.from.Status.CanTransitionTo(c.to.Status) {
+		return statusTransitionError(c.from.Status, c.to.Status)
+	}
+
+	// 予約タイプの変更は承認済みの仮予約から本予約への変更のみ許可する
+	if !c.from.Type.CanTransitionTo(c.to.Type) {
+		return ErrInvalidBookingTypeTransition
+	}
+	if c.from.Type != "" && c.from.Type != c.to.Type && c.to.Status != models.BookingStatusApproved {
+		return ErrTemporaryBookingNotApproved
+	}
+
+	for _, rule := range m.rules {
+		if rule.guard != nil && rule.applies(c) {
+			if err := rule.guard(c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// statusTransitionError 許可されていないステータス遷移を表すエラー
+func statusTransitionError(from, to models.BookingStatus) error {
+	details := map[string]interface{}{"from": from, "to": to}
+	if from != "" {
+		switch to {
+		case models.BookingStatusApproved:
+			return ErrBookingNotApprovable.WithDetails(details)
+		case models.BookingStatusRejected:
+			return ErrBookingNotRejectable.WithDetails(details)
+		case models.BookingStatusCancelled:
+			return ErrBookingNotCancellable.WithDetails(details)
+		}
+	}
+	return ErrInvalidStatusTransition.WithDetails(details)
+}
+
+// Create 新規予約を初期状態で保存する
+// 時間枠を占有する予約にはキープ順序を割り当て、ステータスログを記録する
+// 呼び出し元のトランザクション内で実行する
+func (m *BookingStateMachine) Create(tx *gorm.DB, booking *models.Booking, actor BookingActor, note string) error {
+	now := time.Now()
+	if err := m.Check(models.Booking{}, booking.State(), actor, now); err != nil {
+		return err
+	}
+
+	if booking.Status.IsActive() {
+		keepOrder, err := m.keepQueue.AssignKeepOrder(tx, booking.StartTime, booking.EndTime, booking.BookingType, "")
+		if err != nil {
+			return err
+		}
+		booking.KeepOrder = keepOrder
+	}
+
+	// 承認済みで作成する場合は作成者を承認者とする
+	if booking.Status == models.BookingStatusApproved && booking.ApprovedBy == nil {
+		booking.ApprovedBy = booking.CreatedBy
+		booking.ApprovedAt = &now
+	}
+
+	if err := tx.Create(booking).Error; err != nil {
+		return fmt.Errorf("予約の作成に失敗しました: %w", err)
+	}
+
+	statusLog := models.BookingStatusLog{
+		ID:        uuid.New(),
+		BookingID: booking.ID,
+		NewStatus: booking.Status,
+		ChangedBy: booking.CreatedBy,
+		ChangedAt: now,
+		Note:      note,
+	}
+	if err := tx.Create(&statusLog).Error; err != nil {
+		return fmt.Errorf("ステータスログの記録に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// Transition 既存の予約を指定の状態に遷移させる
+// ガード条件を検証した後、フックを実行しながら予約を更新し、状態が変わった場合（Recordの指定時は常に）ステータスログを記録する
+// 呼び出し元のトランザクション内で、予約の行をロックしてから実行する
+func (m *BookingStateMachine) Transition(tx *gorm.DB, t BookingTransition) (*BookingTransitionResult, error) {
+	c := m.newContext(&t, time.Now())
+	if err := m.check(c); err != nil {
+		return nil, err
+	}
+
+	var rules []bookingTransitionRule
+	for _, rule := range m.rules {
+		if rule.applies(c) {
+			rules = append(rules, rule)
+		}
+	}
+
+	if c.to.Status != c.from.Status {
+		c.updates["status"] = c.to.Status
+	}
+	if c.to.Type != c.from.Type {
+		c.updates["booking_type"] = c.to.Type
+	}
+	c.updates["updated_at"] = c.now
+	if t.ChangedBy != nil {
+		c.updates["updated_by"] = *t.ChangedBy
+	}
+
+	for _, rule := range rules {
+		if rule.before != nil {
+			if err := rule.before(tx, c); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// 遷移前の状態をフックで参照できるよう、更新はコピーに対して行う
+	booking := t.Booking
+	if err := tx.Model(&booking).Updates(c.updates).Error; err != nil {
+		return nil, fmt.Errorf("予約の更新に失敗しました: %w", err)
+	}
+
+	if c.from != c.to || t.Record {
+		note := t.Note + strings.Join(c.notes, "")
+		if t.Reason != "" {
+			note = fmt.Sprintf("%s: %s", note, t.Reason)
+		}
+		statusLog := models.BookingStatusLog{
+			ID:             uuid.New(),
+			BookingID:      booking.ID,
+			PreviousStatus: c.from.Status,
+			NewStatus:      c.to.Status,
+			ChangedBy:      t.ChangedBy,
+			ChangedAt:      c.now,
+			Note:           note,
+		}
+		if err := tx.Create(&statusLog).Error; err != nil {
+			return nil, fmt.Errorf("ステータスログの記録に失敗しました: %w", err)
+		}
+	}
+
+	for _, rule := range rules {
+		if rule.after != nil {
+			if err := rule.after(tx, c); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return c.result, nil
+}
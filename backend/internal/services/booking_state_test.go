@@ -0,0 +1,133 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/zebraApp/internal/models"
+)
+
+// 遷移表で使う状態の略記（ステータス＋予約タイプ）
+var (
+	stateNew                = models.BookingState{}
+	statePendingTemporary   = models.BookingState{Status: models.BookingStatusPending, Type: models.BookingTypeTemporary}
+	statePendingConfirmed   = models.BookingState{Status: models.BookingStatusPending, Type: models.BookingTypeConfirmed}
+	stateApprovedTemporary  = models.BookingState{Status: models.BookingStatusApproved, Type: models.BookingTypeTemporary}
+	stateApprovedConfirmed  = models.BookingState{Status: models.BookingStatusApproved, Type: models.BookingTypeConfirmed}
+	stateRejectedTemporary  = models.BookingState{Status: models.BookingStatusRejected, Type: models.BookingTypeTemporary}
+	stateRejectedConfirmed  = models.BookingState{Status: models.BookingStatusRejected, Type: models.BookingTypeConfirmed}
+	stateCancelledTemporary = models.BookingState{Status: models.BookingStatusCancelled, Type: models.BookingTypeTemporary}
+	stateCancelledConfirmed = models.BookingState{Status: models.BookingStatusCancelled, Type: models.BookingTypeConfirmed}
+)
+
+// transitionTargets 遷移先として取りうるすべての状態（遷移表の列の順）
+var transitionTargets = []models.BookingState{
+	statePendingTemporary, statePendingConfirmed,
+	stateApprovedTemporary, stateApprovedConfirmed,
+	stateRejectedTemporary, stateRejectedConfirmed,
+	stateCancelledTemporary, stateCancelledConfirmed,
+}
+
+func TestBookingStateMachineTransitionMatrix(t *testing.T) {
+	var (
+		ok           error
+		badStatus    = ErrInvalidStatusTransition
+		notApprove   = ErrBookingNotApprovable
+		notReject    = ErrBookingNotRejectable
+		notCancel    = ErrBookingNotCancellable
+		badType      = ErrInvalidBookingTypeTransition
+		needApproved = ErrTemporaryBookingNotApproved
+	)
+
+	// 行は遷移元、列はtransitionTargetsの順の遷移先
+	tests := []struct {
+		from models.BookingState
+		want []error
+	}{
+		{stateNew, []error{ok, ok, ok, ok, badStatus, badStatus, badStatus, badStatus}},
+		{statePendingTemporary, []error{badStatus, badStatus, ok, ok, ok, needApproved, ok, needApproved}},
+		{statePendingConfirmed, []error{badStatus, badStatus, badType, ok, badType, ok, badType, ok}},
+		{stateApprovedTemporary, []error{badStatus, badStatus, notApprove, notApprove, notReject, notReject, ok, needApproved}},
+		{stateApprovedConfirmed, []error{badStatus, badStatus, notApprove, notApprove, notReject, notReject, badType, ok}},
+		{stateRejectedTemporary, []error{badStatus, badStatus, notApprove, notApprove, notReject, notReject, notCancel, notCancel}},
+		{stateRejectedConfirmed, []error{badStatus, badStatus, notApprove, notApprove, notReject, notReject, notCancel, notCancel}},
+		{stateCancelledTemporary, []error{badStatus, badStatus, notApprove, notApprove, notReject, notReject, notCancel, notCancel}},
+		{stateCancelledConfirmed, []error{badStatus, badStatus, notApprove, notApprove, notReject, notReject, notCancel, notCancel}},
+	}
+
+	machine := NewBookingStateMachine(nil, CancellationPolicy{}, nil)
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, tt := range tests {
+		if len(tt.want) != len(transitionTargets) {
+			t.Fatalf("%v: expected %d columns, got %d", tt.from, len(transitionTargets), len(tt.want))
+		}
+		booking := models.Booking{Status: tt.from.Status, BookingType: tt.from.Type}
+
+		for i, to := range transitionTargets {
+			want := tt.want[i]
+			t.Run(fmt.Sprintf("%s/%s->%s/%s", tt.from.Status, tt.from.Type, to.Status, to.Type), func(t *testing.T) {
+				for _, actor := range []BookingActor{BookingActorUser, BookingActorAdmin, BookingActorSystem} {
+					err := machine.Check(booking, to, actor, now)
+					if want == nil && err != nil {
+						t.Errorf("actor %s: expected transition to be allowed, got %v", actor, err)
+					}
+					if want != nil && !errors.Is(err, want) {
+						t.Errorf("actor %s: expected %v, got %v", actor, want, err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestBookingStateMachineGuards(t *testing.T) {
+	machine := NewBookingStateMachine(nil, CancellationPolicy{}, nil)
+	now := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	passed := now.Add(-time.Hour)
+	upcoming := now.Add(time.Hour)
+
+	approvedTemporary := models.Booking{Status: models.BookingStatusApproved, BookingType: models.BookingTypeTemporary}
+	withDeadline := func(deadline time.Time) models.Booking {
+		b := approvedTemporary
+		b.ConfirmationDeadline = &deadline
+		return b
+	}
+	// ステータスを指定しない本予約への変更
+	confirmOnly := models.BookingState{Type: models.BookingTypeConfirmed}
+
+	tests := []struct {
+		name    string
+		booking models.Booking
+		to      models.BookingState
+		actor   BookingActor
+		want    error
+	}{
+		{"unknown status", approvedTemporary, models.BookingState{Status: "archived"}, BookingActorAdmin, ErrInvalidBookingStatus},
+		{"unknown type", approvedTemporary, models.BookingState{Type: "tentative"}, BookingActorAdmin, ErrInvalidBookingType},
+		{"unknown initial status", models.Booking{}, models.BookingState{Status: "archived", Type: models.BookingTypeTemporary}, BookingActorAdmin, ErrInvalidBookingStatus},
+		{"missing initial type", models.Booking{}, models.BookingState{Status: models.BookingStatusPending}, BookingActorUser, ErrInvalidBookingType},
+		{"empty target keeps state", approvedTemporary, models.BookingState{}, BookingActorAdmin, nil},
+		{"field-only update of cancelled booking", models.Booking{Status: models.BookingStatusCancelled, BookingType: models.BookingTypeConfirmed}, models.BookingState{}, BookingActorAdmin, nil},
+		{"user confirms before deadline", withDeadline(upcoming), confirmOnly, BookingActorUser, nil},
+		{"user confirms after deadline", withDeadline(passed), confirmOnly, BookingActorUser, ErrConfirmationDeadlinePassed},
+		{"admin confirms after deadline", withDeadline(passed), confirmOnly, BookingActorAdmin, nil},
+		{"pending temporary cannot be confirmed", models.Booking{Status: models.BookingStatusPending, BookingType: models.BookingTypeTemporary}, confirmOnly, BookingActorAdmin, ErrTemporaryBookingNotApproved},
+		{"approve and confirm pending temporary", models.Booking{Status: models.BookingStatusPending, BookingType: models.BookingTypeTemporary}, stateApprovedConfirmed, BookingActorAdmin, nil},
+		{"user cancels after deadline", withDeadline(passed), stateCancelledTemporary, BookingActorUser, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := machine.Check(tt.booking, tt.to, tt.actor, now)
+			if tt.want == nil && err != nil {
+				t.Fatalf("expected transition to be allowed, got %v", err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
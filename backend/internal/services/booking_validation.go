@@ -0,0 +1,92 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/schedule"
+	"github.com/zebraApp/internal/validation"
+	"gorm.io/gorm"
+)
+
+// BookingValidatorImpl は営業時間と申請者の仮予約数を取得して予約の業務ルールを検証する
+type BookingValidatorImpl struct {
+	db            *gorm.DB
+	rules         validation.Rules
+	businessHours *BusinessHoursServiceImpl
+}
+
+func NewBookingValidator(db *gorm.DB, rules validation.Rules, businessHours *BusinessHoursServiceImpl) *BookingValidatorImpl {
+	return &BookingValidatorImpl{
+		db:            db,
+		rules:         rules,
+		businessHours: businessHours,
+	}
+}
+
+// ValidateBooking 予約内容を業務ルールで検証する。違反がある場合は*validation.Errorを返す
+// 更新時はbooking.IDの予約自身を仮予約数に含めない
+func (s *BookingValidatorImpl) ValidateBooking(tx *gorm.DB, booking models.Booking, now time.Time) error {
+	return s.ValidateAdminBooking(tx, booking, now, false)
+}
+
+// ValidateAdminBooking 管理者による予約内容を検証する
+// overrideRulesを指定した場合は業務ルールを省略し、時間の前後関係のみを検証する
+// 仮予約数はtxで数えるため、作成と同じトランザクションを渡すと定期予約の作成済みの回なども上限に含まれる。
+// 同じユーザーの同時の申請と競合しないよう、数える前に時間枠のロックを取得する
+func (s *BookingValidatorImpl) ValidateAdminBooking(tx *gorm.DB, booking models.Booking, now time.Time, overrideRules bool) error {
+	if overrideRules {
+		return validation.NewValidator(s.rules, nil).Validate(validation.Booking{
+			StartTime:     booking.StartTime,
+			EndTime:       booking.EndTime,
+			BookingType:   booking.BookingType,
+			OverrideRules: true,
+		}, now)
+	}
+
+	calendar, err := s.businessHours.BusinessCalendar()
+	if err != nil {
+		return err
+	}
+
+	activeTemporaryBookings := 0
+	if booking.BookingType == models.BookingTypeTemporary && booking.UserID != nil {
+		if err := lockSlotHolds(tx); err != nil {
+			return err
+		}
+		var count int64
+		if err := tx.Model(&models.Booking{}).
+			Where("user_id = ? AND booking_type = ? AND status IN ? AND id <> ?",
+				*booking.UserID, models.BookingTypeTemporary, activeBookingStatuses, booking.ID).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("仮予約数の取得に失敗しました: %w", err)
+		}
+		activeTemporaryBookings = int(count)
+	}
+
+	return s.validateRules(calendar, booking, now, activeTemporaryBookings)
+}
+
+// validateRules 取得済みの営業時間と申請者の有効な仮予約数で予約内容を検証する
+func (s *BookingValidatorImpl) validateRules(calendar validation.BusinessHours, booking models.Booking, now time.Time, activeTemporaryBookings int) error {
+	return validation.NewValidator(s.rules, calendar).Validate(validation.Booking{
+		StartTime:               booking.StartTime,
+		EndTime:                 booking.EndTime,
+		BookingType:             booking.BookingType,
+		ActiveTemporaryBookings: activeTemporaryBookings,
+	}, now)
+}
+
+// BusinessCalendar 検証に使う営業時間
+func (s *BookingValidatorImpl) BusinessCalendar() (schedule.BusinessCalendar, error) {
+	return s.businessHours.BusinessCalendar()
+}
+
+// Interval 開始・終了時刻の刻み（設定がない場合は最小の刻み）
+func (s *BookingValidatorImpl) Interval() time.Duration {
+	if s.rules.IntervalMinutes <= 0 {
+		return validation.MinimumIntervalMinutes * time.Minute
+	}
+	return time.Duration(s.rules.IntervalMinutes) * time.Minute
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/validation"
+)
+
+// TestSeriesTemporaryBookingLimit 定期予約の各回を同じトランザクションで検証したときに、
+// 作成済みの回を仮予約数に含めて上限を超える回を作成しないことを確かめる
+func TestSeriesTemporaryBookingLimit(t *testing.T) {
+	loc := time.FixedZone("JST", 9*60*60)
+	validator := &BookingValidatorImpl{rules: validation.Rules{MaxTemporaryBookings: 3, Location: loc}}
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, loc)
+	userID := uuid.New()
+
+	// 既存の仮予約1件に加えて毎週5回の仮予約を作成する
+	existing := 1
+	created := 0
+	var rejected []int
+	for week := 0; week < 5; week++ {
+		start := time.Date(2025, 4, 8, 10, 0, 0, 0, loc).AddDate(0, 0, 7*week)
+		booking := models.Booking{
+			ID:          uuid.New(),
+			UserID:      &userID,
+			StartTime:   start,
+			EndTime:     start.Add(2 * time.Hour),
+			BookingType: models.BookingTypeTemporary,
+		}
+
+		// トランザクション内では作成済みの回も有効な仮予約として数えられる
+		err := validator.validateRules(nil, booking, now, existing+created)
+		if err == nil {
+			created++
+			continue
+		}
+		var violation *validation.Error
+		if !errors.As(err, &violation) || violation.Violations[0].Code != validation.CodeTemporaryBookingLimitExceeded {
+			t.Fatalf("week %d: expected temporary booking limit violation, got %v", week, err)
+		}
+		rejected = append(rejected, week)
+	}
+
+	if created != 2 {
+		t.Fatalf("expected 2 occurrences within the limit, got %d", created)
+	}
+	if len(rejected) != 3 || rejected[0] != 2 {
+		t.Fatalf("expected weeks 2-4 to be rejected, got %v", rejected)
+	}
+}
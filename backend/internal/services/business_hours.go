@@ -0,0 +1,280 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/holiday"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/schedule"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrBusinessHoursNotFound は営業時間設定が存在しないことを表す
+	ErrBusinessHoursNotFound = NewNotFoundError("NOT_FOUND", "business_hours.not_found")
+	// ErrInvalidBusinessHours は営業時間設定の内容が不正であることを表す
+	ErrInvalidBusinessHours = NewValidationError("INVALID_PARAMETERS", "code.INVALID_PARAMETERS")
+)
+
+// BusinessHoursServiceImpl は曜日ごとの営業時間と特定日・祝日の例外を管理する
+type BusinessHoursServiceImpl struct {
+	db       *gorm.DB
+	location *time.Location
+	holidays holiday.Source
+}
+
+func NewBusinessHoursService(db *gorm.DB, loc *time.Location, holidays holiday.Source) *BusinessHoursServiceImpl {
+	if loc == nil {
+		loc = time.Local
+	}
+	return &BusinessHoursServiceImpl{db: db, location: loc, holidays: holidays}
+}
+
+// BusinessCalendar 営業時間設定を営業日カレンダーとして取得する
+func (s *BusinessHoursServiceImpl) BusinessCalendar() (schedule.BusinessCalendar, error) {
+	var rows []models.BusinessHours
+	if err := s.db.Find(&rows).Error; err != nil {
+		return schedule.BusinessCalendar{}, fmt.Errorf("営業時間の取得に失敗しました: %w", err)
+	}
+
+	calendar := schedule.BusinessCalendar{
+		Weekly:   map[time.Weekday]schedule.DayHours{},
+		Dates:    map[string]schedule.DayHours{},
+		Location: s.location,
+	}
+	if s.holidays != nil {
+		holidays, err := s.holidays.Holidays()
+		if err != nil {
+			return schedule.BusinessCalendar{}, fmt.Errorf("祝日の取得に失敗しました: %w", err)
+		}
+		calendar.IsHoliday = holiday.Checker(holidays)
+	}
+	for _, row := range rows {
+		hours := schedule.DayHours{Closed: row.IsClosed}
+		if !row.IsClosed && row.OpenMinute != nil && row.CloseMinute != nil {
+			hours.OpenMinute = *row.OpenMinute
+			hours.CloseMinute = *row.CloseMinute
+		}
+
+		switch row.Kind {
+		case models.BusinessHoursKindWeekly:
+			if row.Weekday != nil {
+				calendar.Weekly[time.Weekday(*row.Weekday)] = hours
+			}
+		case models.BusinessHoursKindDate:
+			if row.Date != nil {
+				calendar.Dates[schedule.DateKey(*row.Date)] = hours
+			}
+		case models.BusinessHoursKindHoliday:
+			holiday := hours
+			calendar.Holiday = &holiday
+		}
+	}
+	return calendar, nil
+}
+
+// GetBusinessHours 営業時間設定一覧取得
+func (s *BusinessHoursServiceImpl) GetBusinessHours() ([]BusinessHoursResponse, error) {
+	var rows []models.BusinessHours
+	if err := s.db.Order("kind ASC, weekday ASC, date ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("営業時間の取得に失敗しました: %w", err)
+	}
+
+	responses := make([]BusinessHoursResponse, len(rows))
+	for i, row := range rows {
+		responses[i] = convertToBusinessHoursResponse(row)
+	}
+	return responses, nil
+}
+
+// CreateBusinessHours 営業時間設定作成
+func (s *BusinessHoursServiceImpl) CreateBusinessHours(req BusinessHoursRequest) (*BusinessHoursResponse, error) {
+	row := models.BusinessHours{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := applyBusinessHoursRequest(&row, req); err != nil {
+		return nil, err
+	}
+	if err := s.ensureUniqueBusinessHours(row); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Create(&row).Error; err != nil {
+		return nil, fmt.Errorf("営業時間の作成に失敗しました: %w", err)
+	}
+
+	response := convertToBusinessHoursResponse(row)
+	return &response, nil
+}
+
+// UpdateBusinessHours 営業時間設定更新
+func (s *BusinessHoursServiceImpl) UpdateBusinessHours(businessHoursID string, req BusinessHoursRequest) (*BusinessHoursResponse, error) {
+	row, err := s.findBusinessHours(businessHoursID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyBusinessHoursRequest(row, req); err != nil {
+		return nil, err
+	}
+	if err := s.ensureUniqueBusinessHours(*row); err != nil {
+		return nil, err
+	}
+	row.UpdatedAt = time.Now()
+
+	if err := s.db.Save(row).Error; err != nil {
+		return nil, fmt.Errorf("営業時間の更新に失敗しました: %w", err)
+	}
+
+	response := convertToBusinessHoursResponse(*row)
+	return &response, nil
+}
+
+// DeleteBusinessHours 営業時間設定削除
+// 曜日の設定を削除するとその曜日は休業日になる。既存の予約には影響しない
+func (s *BusinessHoursServiceImpl) DeleteBusinessHours(businessHoursID string) error {
+	row, err := s.findBusinessHours(businessHoursID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.Delete(row).Error; err != nil {
+		return fmt.Errorf("営業時間の削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+func (s *BusinessHoursServiceImpl) findBusinessHours(businessHoursID string) (*models.BusinessHours, error) {
+	if _, err := uuid.Parse(businessHoursID); err != nil {
+		return nil, ErrBusinessHoursNotFound
+	}
+
+	var row models.BusinessHours
+	if err := s.db.First(&row, "id = ?", businessHoursID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBusinessHoursNotFound
+		}
+		return nil, fmt.Errorf("営業時間の取得に失敗しました: %w", err)
+	}
+	return &row, nil
+}
+
+// ensureUniqueBusinessHours 同じ曜日・日付・祝日の設定が重複しないことを確認する
+func (s *BusinessHoursServiceImpl) ensureUniqueBusinessHours(row models.BusinessHours) error {
+	query := s.db.Model(&models.BusinessHours{}).Where("kind = ? AND id <> ?", row.Kind, row.ID)
+	switch row.Kind {
+	case models.BusinessHoursKindWeekly:
+		query = query.Where("weekday = ?", *row.Weekday)
+	case models.BusinessHoursKindDate:
+		query = query.Where("date = ?", row.Date.Format("2006-01-02"))
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return fmt.Errorf("営業時間の確認に失敗しました: %w", err)
+	}
+	if count > 0 {
+		return ErrInvalidBusinessHours.WithMessage("business_hours.duplicate")
+	}
+	return nil
+}
+
+// applyBusinessHoursRequest リクエストの内容を検証して営業時間設定に反映する
+func applyBusinessHoursRequest(row *models.BusinessHours, req BusinessHoursRequest) error {
+	kind := models.BusinessHoursKind(req.Kind)
+	row.Weekday = nil
+	row.Date = nil
+
+	switch kind {
+	case models.BusinessHoursKindWeekly:
+		if req.Weekday == nil || *req.Weekday < int(time.Sunday) || *req.Weekday > int(time.Saturday) {
+			return ErrInvalidBusinessHours.WithMessage("business_hours.invalid_weekday")
+		}
+		weekday := *req.Weekday
+		row.Weekday = &weekday
+	case models.BusinessHoursKindDate:
+		date, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			return ErrInvalidBusinessHours.WithMessage("business_hours.invalid_date")
+		}
+		row.Date = &date
+	case models.BusinessHoursKindHoliday:
+	default:
+		return ErrInvalidBusinessHours.WithMessage("business_hours.invalid_kind")
+	}
+
+	row.Kind = kind
+	row.IsClosed = req.IsClosed
+	row.Note = req.Note
+	row.OpenMinute = nil
+	row.CloseMinute = nil
+	if req.IsClosed {
+		return nil
+	}
+
+	openMinute, err := parseClockMinutes(req.OpenTime)
+	if err != nil || openMinute >= 24*60 {
+		return ErrInvalidBusinessHours.WithMessage("business_hours.invalid_open")
+	}
+	closeMinute, err := parseClockMinutes(req.CloseTime)
+	if err != nil {
+		return ErrInvalidBusinessHours.WithMessage("business_hours.invalid_close")
+	}
+	if closeMinute <= openMinute {
+		return ErrInvalidBusinessHours.WithMessage("business_hours.close_before_open")
+	}
+	row.OpenMinute = &openMinute
+	row.CloseMinute = &closeMinute
+	return nil
+}
+
+func convertToBusinessHoursResponse(row models.BusinessHours) BusinessHoursResponse {
+	response := BusinessHoursResponse{
+		ID:        row.ID.String(),
+		Kind:      string(row.Kind),
+		Weekday:   row.Weekday,
+		IsClosed:  row.IsClosed,
+		Note:      row.Note,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+	if row.Date != nil {
+		response.Date = row.Date.Format("2006-01-02")
+	}
+	if row.OpenMinute != nil {
+		response.OpenTime = formatClockMinutes(*row.OpenMinute)
+	}
+	if row.CloseMinute != nil {
+		response.CloseTime = formatClockMinutes(*row.CloseMinute)
+	}
+	return response
+}
+
+// コントローラーと共有するリクエスト・レスポンス型
+type BusinessHoursRequest struct {
+	Kind      string `json:"kind"`              // weekly, date, holiday
+	Weekday   *int   `json:"weekday,omitempty"` // weeklyのみ。0: 日曜 〜 6: 土曜
+	Date      string `json:"date,omitempty"`    // dateのみ。YYYY-MM-DD
+	IsClosed  bool   `json:"isClosed"`
+	OpenTime  string `json:"openTime,omitempty"`  // HH:MM
+	CloseTime string `json:"closeTime,omitempty"` // HH:MM（24:00可）
+	Note      string `json:"note"`
+}
+
+type BusinessHoursResponse struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	Weekday   *int      `json:"weekday,omitempty"`
+	Date      string    `json:"date,omitempty"`
+	IsClosed  bool      `json:"isClosed"`
+	OpenTime  string    `json:"openTime,omitempty"`
+	CloseTime string    `json:"closeTime,omitempty"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -4,10 +4,36 @@ import (
 	"database/sql"
 	"fmt"
 	"time"
+
+	"github.com/zebraApp/internal/auth"
+	"github.com/zebraApp/internal/holiday"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/pricing"
+	"github.com/zebraApp/internal/schedule"
+)
+
+const (
+	// availabilitySlotMinutes は空き状況の時間枠の長さ（分）
+	availabilitySlotMinutes = 60
+	// windowSearchDefaultGranularity は空き枠検索の開始時刻の既定の刻み（分）
+	windowSearchDefaultGranularity = 30
+	// windowSearchMaxDays は空き枠検索で一度に指定できる期間（日数）
+	windowSearchMaxDays = 62
 )
 
+// windowSearchGranularities は空き枠検索で指定できる刻み（分）
+var windowSearchGranularities = map[int]bool{10: true, 30: true, 60: true}
+
+// ErrInvalidWindowSearch は空き枠検索の条件が正しくないことを表す
+var ErrInvalidWindowSearch = NewValidationError("INVALID_PARAMETERS", "code.INVALID_PARAMETERS")
+
 type CalendarServiceImpl struct {
-	db *sql.DB
+	db            *sql.DB
+	pricing       *pricing.Engine
+	businessHours *BusinessHoursServiceImpl
+	holidays      holiday.Source
+	// location はレスポンスの日時を表すスタジオのタイムゾーン
+	location *time.Location
 }
 
 type BookingData struct {
@@ -23,12 +49,16 @@ type BookingData struct {
 	CreatedAt        time.Time
 }
 
-func NewCalendarService(db *sql.DB) *CalendarServiceImpl {
-	return &CalendarServiceImpl{db: db}
+func NewCalendarService(db *sql.DB, pricingEngine *pricing.Engine, businessHours *BusinessHoursServiceImpl, holidays holiday.Source, loc *time.Location) *CalendarServiceImpl {
+	if loc == nil {
+		loc = time.Local
+	}
+	return &CalendarServiceImpl{db: db, pricing: pricingEngine, businessHours: businessHours, holidays: holidays, location: loc}
 }
 
 // GetEvents 指定期間の予約イベントを取得
-func (s *CalendarServiceImpl) GetEvents(startDate, endDate time.Time, userID string) ([]EventResponse, error) {
+// 管理者と予約したユーザー本人以外（未認証を含む）には、利用者や目的を含まない予約済みの時間帯のみを返す
+func (s *CalendarServiceImpl) GetEvents(startDate, endDate time.Time, viewer *auth.Principal) ([]EventResponse, error) {
 	query := `
 		SELECT 
 			b.id,
@@ -71,7 +101,10 @@ func (s *CalendarServiceImpl) GetEvents(startDate, endDate time.Time, userID str
 			return nil, fmt.Errorf("failed to scan booking: %w", err)
 		}
 
-		event := s.formatBookingAsEvent(booking)
+		event := s.formatBusyEvent(booking)
+		if canViewBookingDetails(viewer, booking) {
+			event = s.formatBookingAsEvent(booking)
+		}
 		events = append(events, event)
 	}
 
@@ -83,67 +116,152 @@ func (s *CalendarServiceImpl) GetEvents(startDate, endDate time.Time, userID str
 }
 
 // GetAvailability 指定期間の空き状況を取得
+// 営業時間は営業時間設定テーブル（曜日・特定日・祝日）に従い、祝日の枠には祝日名を付ける
 func (s *CalendarServiceImpl) GetAvailability(startDate, endDate time.Time) ([]AvailabilitySlot, error) {
-	var availability []AvailabilitySlot
-
-	// 営業時間の設定（現在はハードコード、将来的には設定テーブルから取得）
-	businessStart := 9 // 9:00
-	businessEnd := 22  // 22:00
-	slotDuration := 60 // 60分単位
-
 	// 既存の予約を取得
 	bookedSlots, err := s.getBookedSlots(startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
 
-	// 日付ごとに空き状況を生成
-	current := startDate
-	for current.Before(endDate) {
-		// 平日のみ営業（土日は休業）
-		if current.Weekday() == time.Saturday || current.Weekday() == time.Sunday {
-			current = current.Add(24 * time.Hour)
-			continue
-		}
+	// 営業時間・料金帯・祝日は期間全体で1回だけ取得する
+	businessCalendar, err := s.businessHours.BusinessCalendar()
+	if err != nil {
+		return nil, err
+	}
+	holidays, err := s.holidays.Holidays()
+	if err != nil {
+		return nil, err
+	}
+	rateTable, err := s.pricing.RateTable()
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAvailability(availabilityInput{
+		StartDate:    startDate,
+		EndDate:      endDate,
+		SlotDuration: availabilitySlotMinutes * time.Minute,
+		Booked:       bookedSlots,
+		Calendar:     businessCalendar,
+		Holidays:     holidays,
+		Rates:        rateTable,
+	}), nil
+}
 
-		// 営業時間内のタイムスロットを生成
-		for hour := businessStart; hour < businessEnd; hour++ {
-			slotStart := time.Date(current.Year(), current.Month(), current.Day(), hour, 0, 0, 0, current.Location())
-			slotEnd := slotStart.Add(time.Duration(slotDuration) * time.Minute)
+// availabilityInput は空き状況の生成に必要なデータ
+type availabilityInput struct {
+	StartDate    time.Time
+	EndDate      time.Time
+	SlotDuration time.Duration
+	Booked       []schedule.Interval
+	Calendar     schedule.BusinessCalendar
+	Holidays     holiday.Provider
+	Rates        pricing.RateTable
+}
 
-			// この時間枠が利用可能かチェック
-			available := true
-			slotType := "business_hours"
+// buildAvailability 営業時間内の時間枠を生成し、予約の有無・料金・祝日名を付ける
+// 予約済みの時間帯は最初に並べ替えて結合し、時間枠を先頭から1つのカーソルで突き合わせる
+func buildAvailability(in availabilityInput) []AvailabilitySlot {
+	var availability []AvailabilitySlot
+	booked := schedule.NewTimeline(in.Booked).Cursor()
 
-			for _, booked := range bookedSlots {
-				if s.timeSlotsOverlap(slotStart, slotEnd, booked.Start, booked.End) {
-					available = false
-					break
-				}
+	// 日付ごとに空き状況を生成
+	for current := in.StartDate; current.Before(in.EndDate); current = current.AddDate(0, 0, 1) {
+		open, close, ok := in.Calendar.OpenPeriod(current)
+		if !ok {
+			continue // 休業日
+		}
+		holidayName, _ := in.Holidays.Lookup(current)
+
+		// 営業時間内のタイムスロットを生成（最後の枠は閉店時刻まで）
+		for slotStart := open; slotStart.Before(close); slotStart = slotStart.Add(in.SlotDuration) {
+			slotEnd := slotStart.Add(in.SlotDuration)
+			if slotEnd.After(close) {
+				slotEnd = close
 			}
 
 			slot := AvailabilitySlot{
 				Start:     slotStart.Format(time.RFC3339),
 				End:       slotEnd.Format(time.RFC3339),
-				Available: available,
-				Type:      slotType,
+				Available: booked.Free(slotStart, slotEnd),
+				Type:      "business_hours",
+				Holiday:   holidayName,
+			}
+			for i, segment := range in.Rates.Segments(slotStart, slotEnd) {
+				if i == 0 {
+					slot.RatePlan = segment.Name
+				}
+				slot.Price += segment.Amount()
 			}
 			availability = append(availability, slot)
 		}
+	}
+
+	return availability
+}
+
+// SearchWindows 指定の長さの予約を入れられる開始時刻を検索する
+// 営業時間（希望の時間帯があればその範囲）から予約済みの時間帯を除いた空き区間を求め、
+// 空き区間ごとに刻みに沿った開始時刻を列挙する
+func (s *CalendarServiceImpl) SearchWindows(startDate, endDate time.Time, req WindowSearchRequest) (*WindowSearchResponse, error) {
+	duration, granularity, within, err := req.parse()
+	if err != nil {
+		return nil, err
+	}
+	if endDate.After(startDate.AddDate(0, 0, windowSearchMaxDays)) {
+		return nil, ErrInvalidWindowSearch.WithMessage("window_search.range_too_long", windowSearchMaxDays)
+	}
+
+	businessCalendar, err := s.businessHours.BusinessCalendar()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WindowSearchResponse{
+		Duration:    req.Duration,
+		Granularity: int(granularity / time.Minute),
+		Windows:     []FreeWindow{},
+	}
 
-		current = current.Add(24 * time.Hour)
+	open := businessCalendar.OpenIntervals(startDate, endDate, within)
+	if len(open) == 0 {
+		return response, nil
 	}
 
-	return availability, nil
+	// 営業時間が日をまたぐ場合に備え、最後の営業区間の終わりまでの予約を取得する
+	bookedSlots, err := s.getBookedSlots(open[0].Start, open[len(open)-1].End)
+	if err != nil {
+		return nil, err
+	}
+	free := schedule.NewTimeline(bookedSlots).FreeWithin(open)
+	for _, start := range schedule.StartTimes(free, duration, granularity, businessCalendar.Location) {
+		response.Windows = append(response.Windows, FreeWindow{
+			Start: start.In(s.location).Format(time.RFC3339),
+			End:   start.Add(duration).In(s.location).Format(time.RFC3339),
+		})
+	}
+	return response, nil
+}
+
+// QuotePrice 指定時間の利用料金の内訳を見積もる（オプションを除く）
+func (s *CalendarServiceImpl) QuotePrice(startTime, endTime time.Time) (models.PriceBreakdown, error) {
+	return s.pricing.Calculate(startTime, endTime, nil)
 }
 
 // 既存の予約された時間枠を取得
-func (s *CalendarServiceImpl) getBookedSlots(startDate, endDate time.Time) ([]struct{ Start, End time.Time }, error) {
+// 予約の変更申請で仮押さえされている時間帯も予約済みとして扱う
+func (s *CalendarServiceImpl) getBookedSlots(startDate, endDate time.Time) ([]schedule.Interval, error) {
 	query := `
 		SELECT start_time, end_time
 		FROM bookings
 		WHERE start_time < $1 AND end_time > $2
 		  AND status IN ('approved', 'pending')
+		UNION ALL
+		SELECT start_time, end_time
+		FROM booking_change_requests
+		WHERE start_time < $1 AND end_time > $2
+		  AND status = 'pending'
 		ORDER BY start_time ASC
 	`
 
@@ -153,24 +271,22 @@ func (s *CalendarServiceImpl) getBookedSlots(startDate, endDate time.Time) ([]st
 	}
 	defer rows.Close()
 
-	var bookedSlots []struct{ Start, End time.Time }
+	var bookedSlots []schedule.Interval
 	for rows.Next() {
-		var slot struct{ Start, End time.Time }
+		var slot schedule.Interval
 		err := rows.Scan(&slot.Start, &slot.End)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan booked slot: %w", err)
 		}
 		bookedSlots = append(bookedSlots, slot)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
 
 	return bookedSlots, nil
 }
 
-// 時間枠の重複チェック
-func (s *CalendarServiceImpl) timeSlotsOverlap(start1, end1, start2, end2 time.Time) bool {
-	return start1.Before(end2) && start2.Before(end1)
-}
-
 // 予約データをイベント形式に変換
 func (s *CalendarServiceImpl) formatBookingAsEvent(booking BookingData) EventResponse {
 	colors := s.getEventColor(booking.Status, booking.BookingType)
@@ -183,8 +299,8 @@ func (s *CalendarServiceImpl) formatBookingAsEvent(booking BookingData) EventRes
 	return EventResponse{
 		ID:              booking.ID,
 		Title:           title,
-		Start:           booking.StartTime.Format(time.RFC3339),
-		End:             booking.EndTime.Format(time.RFC3339),
+		Start:           booking.StartTime.In(s.location).Format(time.RFC3339),
+		End:             booking.EndTime.In(s.location).Format(time.RFC3339),
 		BackgroundColor: colors.BackgroundColor,
 		BorderColor:     colors.BorderColor,
 		TextColor:       colors.TextColor,
@@ -195,7 +311,31 @@ func (s *CalendarServiceImpl) formatBookingAsEvent(booking BookingData) EventRes
 			"bookingType":      booking.BookingType,
 			"purpose":          booking.Purpose,
 			"photographerName": booking.PhotographerName.String,
-			"createdAt":        booking.CreatedAt.Format(time.RFC3339),
+			"createdAt":        booking.CreatedAt.In(s.location).Format(time.RFC3339),
+		},
+	}
+}
+
+// canViewBookingDetails 予約の利用者・目的などの詳細を閲覧できるかどうか
+func canViewBookingDetails(viewer *auth.Principal, booking BookingData) bool {
+	if viewer == nil {
+		return false
+	}
+	return viewer.IsAdmin || viewer.ID == booking.UserID
+}
+
+// 予約データを詳細を含まない予約済みの時間帯に変換
+func (s *CalendarServiceImpl) formatBusyEvent(booking BookingData) EventResponse {
+	return EventResponse{
+		ID:              booking.ID,
+		Title:           "予約済み",
+		Start:           booking.StartTime.In(s.location).Format(time.RFC3339),
+		End:             booking.EndTime.In(s.location).Format(time.RFC3339),
+		BackgroundColor: "#6B7280", // gray-500
+		BorderColor:     "#4B5563", // gray-600
+		TextColor:       "#FFFFFF",
+		ExtendedProps: map[string]interface{}{
+			"busy": true,
 		},
 	}
 }
@@ -239,7 +379,7 @@ func (s *CalendarServiceImpl) getEventColor(status, bookingType string) struct {
 	}{backgroundColor, borderColor, textColor}
 }
 
-// インターフェースの実装確認用の型定義
+// コントローラーと共有するレスポンス型
 type EventResponse struct {
 	ID              string                 `json:"id"`
 	Title           string                 `json:"title"`
@@ -251,9 +391,72 @@ type EventResponse struct {
 	ExtendedProps   map[string]interface{} `json:"extendedProps"`
 }
 
+// WindowSearchRequest は空き枠検索の条件（期間はクエリのstart・endで指定する）
+type WindowSearchRequest struct {
+	Duration    int    `query:"duration"`    // 予約したい長さ（分）
+	Granularity int    `query:"granularity"` // 開始時刻の刻み（分、10・30・60。省略時は30）
+	From        string `query:"from"`        // 希望の時間帯の開始（"HH:MM"）
+	Until       string `query:"until"`       // 希望の時間帯の終了（"HH:MM"、"24:00"まで）
+}
+
+// WindowSearchResponse は空き枠検索の結果
+type WindowSearchResponse struct {
+	Duration    int          `json:"duration"`
+	Granularity int          `json:"granularity"`
+	Windows     []FreeWindow `json:"windows"`
+}
+
+// parse 検索条件を検証して、長さ・刻み・希望の時間帯に変換する
+func (r WindowSearchRequest) parse() (time.Duration, time.Duration, *schedule.TimeOfDay, error) {
+	if r.Duration <= 0 || r.Duration > 24*60 {
+		return 0, 0, nil, ErrInvalidWindowSearch.WithMessage("window_search.invalid_duration")
+	}
+
+	granularity := r.Granularity
+	if granularity == 0 {
+		granularity = windowSearchDefaultGranularity
+	}
+	if !windowSearchGranularities[granularity] {
+		return 0, 0, nil, ErrInvalidWindowSearch.WithMessage("window_search.invalid_granularity")
+	}
+
+	var within *schedule.TimeOfDay
+	if r.From != "" || r.Until != "" {
+		within = &schedule.TimeOfDay{FromMinute: 0, ToMinute: 24 * 60}
+		if r.From != "" {
+			minute, err := parseClockMinutes(r.From)
+			if err != nil {
+				return 0, 0, nil, ErrInvalidWindowSearch.WithMessage("window_search.invalid_from")
+			}
+			within.FromMinute = minute
+		}
+		if r.Until != "" {
+			minute, err := parseClockMinutes(r.Until)
+			if err != nil {
+				return 0, 0, nil, ErrInvalidWindowSearch.WithMessage("window_search.invalid_until")
+			}
+			within.ToMinute = minute
+		}
+		if within.ToMinute <= within.FromMinute {
+			return 0, 0, nil, ErrInvalidWindowSearch.WithMessage("window_search.until_before_from")
+		}
+	}
+
+	return time.Duration(r.Duration) * time.Minute, time.Duration(granularity) * time.Minute, within, nil
+}
+
+// FreeWindow は予約を入れられる枠
+type FreeWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
 type AvailabilitySlot struct {
 	Start     string `json:"start"`
 	End       string `json:"end"`
 	Available bool   `json:"available"`
-	Type      string `json:"type"`
+	Type      string `json:"type"`               // "business_hours", "break", "blocked"
+	Price     int    `json:"price"`              // 枠の利用料金（円、税抜）
+	RatePlan  string `json:"ratePlan,omitempty"` // 枠の開始時刻に適用される料金帯
+	Holiday   string `json:"holiday,omitempty"`  // 祝日の場合は祝日名
 }
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zebraApp/internal/auth"
+	"github.com/zebraApp/internal/holiday"
+	"github.com/zebraApp/internal/pricing"
+	"github.com/zebraApp/internal/schedule"
+)
+
+// benchmarkLocation はベンチマークで使うスタジオのタイムゾーン
+var benchmarkLocation = time.FixedZone("JST", 9*60*60)
+
+// syntheticYearOfBookings 1年分の予約を生成する
+// 営業時間内に1日あたり0〜6件、1〜6時間の予約を30分刻みで置き、キープによる重複も含める
+func syntheticYearOfBookings(year int) []schedule.Interval {
+	r := rand.New(rand.NewSource(int64(year)))
+	var bookings []schedule.Interval
+	for day := time.Date(year, 1, 1, 0, 0, 0, 0, benchmarkLocation); day.Year() == year; day = day.AddDate(0, 0, 1) {
+		for n := r.Intn(7); n > 0; n-- {
+			start := day.Add(9*time.Hour + time.Duration(r.Intn(24))*30*time.Minute)
+			end := start.Add(time.Duration(2+r.Intn(11)) * 30 * time.Minute)
+			bookings = append(bookings, schedule.Interval{Start: start, End: end})
+		}
+	}
+	// 取得順に依存しないことを確かめるため並びを崩す
+	r.Shuffle(len(bookings), func(i, j int) { bookings[i], bookings[j] = bookings[j], bookings[i] })
+	return bookings
+}
+
+// benchmarkCalendar 平日9:00〜22:00、土日祝10:00〜20:00の営業時間
+func benchmarkCalendar(holidays holiday.Provider) schedule.BusinessCalendar {
+	weekday := schedule.DayHours{OpenMinute: 9 * 60, CloseMinute: 22 * 60}
+	weekend := schedule.DayHours{OpenMinute: 10 * 60, CloseMinute: 20 * 60}
+	return schedule.BusinessCalendar{
+		Weekly: map[time.Weekday]schedule.DayHours{
+			time.Sunday: weekend, time.Monday: weekday, time.Tuesday: weekday, time.Wednesday: weekday,
+			time.Thursday: weekday, time.Friday: weekday, time.Saturday: weekend,
+		},
+		Holiday:   &weekend,
+		Location:  benchmarkLocation,
+		IsHoliday: holiday.Checker(holidays),
+	}
+}
+
+// benchmarkRates 夜間・週末の料金帯
+func benchmarkRates(holidays holiday.Provider) pricing.RateTable {
+	return pricing.RateTable{
+		Bands: []pricing.RateBand{
+			{Name: "夜間料金", WeekdayMask: pricing.WeekdayMask(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday),
+				StartMinute: 18 * 60, EndMinute: 24 * 60, HourlyRate: 12000, Priority: 1},
+			{Name: "休日料金", WeekdayMask: pricing.WeekdayMask(time.Saturday, time.Sunday), AppliesToHolidays: true,
+				StartMinute: 0, EndMinute: 24 * 60, HourlyRate: 15000, Priority: 2},
+		},
+		DefaultName: "基本料金",
+		DefaultRate: 10000,
+		Location:    benchmarkLocation,
+		IsHoliday:   holiday.Checker(holidays),
+	}
+}
+
+func BenchmarkBuildAvailability(b *testing.B) {
+	const year = 2026
+	holidays := holiday.NewJapan()
+	booked := syntheticYearOfBookings(year)
+	start := time.Date(year, 1, 1, 0, 0, 0, 0, benchmarkLocation)
+
+	for _, minutes := range []int{60, 30, 10} {
+		b.Run(fmt.Sprintf("year/%dmin", minutes), func(b *testing.B) {
+			in := availabilityInput{
+				StartDate:    start,
+				EndDate:      start.AddDate(1, 0, 0),
+				SlotDuration: time.Duration(minutes) * time.Minute,
+				Booked:       booked,
+				Calendar:     benchmarkCalendar(holidays),
+				Holidays:     holidays,
+				Rates:        benchmarkRates(holidays),
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				buildAvailability(in)
+			}
+		})
+	}
+}
+
+func BenchmarkFreeWindowSearch(b *testing.B) {
+	const year = 2026
+	holidays := holiday.NewJapan()
+	booked := syntheticYearOfBookings(year)
+	start := time.Date(year, 1, 1, 0, 0, 0, 0, benchmarkLocation)
+	calendar := benchmarkCalendar(holidays)
+
+	for _, minutes := range []int{60, 30, 10} {
+		b.Run(fmt.Sprintf("year/3h/%dmin", minutes), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				open := calendar.OpenIntervals(start, start.AddDate(1, 0, 0), nil)
+				free := schedule.NewTimeline(booked).FreeWithin(open)
+				schedule.StartTimes(free, 3*time.Hour, time.Duration(minutes)*time.Minute, benchmarkLocation)
+			}
+		})
+	}
+}
+
+func TestCalendarEventVisibility(t *testing.T) {
+	s := NewCalendarService(nil, nil, nil, nil, benchmarkLocation)
+	booking := BookingData{
+		ID:          "booking-1",
+		UserID:      "owner",
+		UserName:    "山田 太郎",
+		StartTime:   time.Date(2025, 4, 1, 10, 0, 0, 0, benchmarkLocation),
+		EndTime:     time.Date(2025, 4, 1, 12, 0, 0, 0, benchmarkLocation),
+		Status:      "approved",
+		BookingType: "confirmed",
+		Purpose:     "商品撮影",
+	}
+
+	tests := []struct {
+		name   string
+		viewer *auth.Principal
+		want   bool
+	}{
+		{"anonymous", nil, false},
+		{"other user", &auth.Principal{ID: "other"}, false},
+		{"owner", &auth.Principal{ID: "owner"}, true},
+		{"admin", &auth.Principal{ID: "admin", IsAdmin: true}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canViewBookingDetails(tt.viewer, booking); got != tt.want {
+				t.Fatalf("expected details visible=%v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	busy := s.formatBusyEvent(booking)
+	if strings.Contains(busy.Title, booking.UserName) || strings.Contains(busy.Title, booking.Purpose) {
+		t.Errorf("busy event title leaks booking details: %q", busy.Title)
+	}
+	for _, key := range []string{"userId", "userName", "purpose", "photographerName"} {
+		if _, ok := busy.ExtendedProps[key]; ok {
+			t.Errorf("busy event exposes %s", key)
+		}
+	}
+	if busy.Start != "2025-04-01T10:00:00+09:00" || busy.End != "2025-04-01T12:00:00+09:00" {
+		t.Errorf("unexpected busy period %s - %s", busy.Start, busy.End)
+	}
+}
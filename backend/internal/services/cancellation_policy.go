@@ -0,0 +1,134 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zebraApp/internal/models"
+)
+
+// CancellationFeeTier はキャンセル料の1段階を表す
+// 利用日のMinDaysBefore日前以降のキャンセルにFeePercentを適用する
+type CancellationFeeTier struct {
+	MinDaysBefore int
+	FeePercent    float64
+}
+
+// CancellationPolicy は予約タイプごとのキャンセル料規定
+type CancellationPolicy struct {
+	// 予約タイプごとの段階（MinDaysBeforeの降順）。未定義のタイプはキャンセル料なし
+	Tiers map[models.BookingType][]CancellationFeeTier
+	// 利用日までの日数を数える際のタイムゾーン
+	Location *time.Location
+}
+
+// NewCancellationPolicy 予約タイプごとのキャンセル料段階からポリシーを作成する
+// 段階を指定しない予約タイプはキャンセル料なし
+func NewCancellationPolicy(tiersByType map[models.BookingType][]CancellationFeeTier, loc *time.Location) CancellationPolicy {
+	policyTiers := make(map[models.BookingType][]CancellationFeeTier, len(tiersByType))
+	for bookingType, typeTiers := range tiersByType {
+		tiers := append([]CancellationFeeTier(nil), typeTiers...)
+		sort.Slice(tiers, func(i, j int) bool {
+			return tiers[i].MinDaysBefore > tiers[j].MinDaysBefore
+		})
+		policyTiers[bookingType] = tiers
+	}
+
+	if loc == nil {
+		loc = time.Local
+	}
+
+	return CancellationPolicy{
+		Tiers:    policyTiers,
+		Location: loc,
+	}
+}
+
+// ParseCancellationFeeTiers "日数:料率"のカンマ区切り（例: "6:0,4:50,1:80,0:100"）を解析する
+func ParseCancellationFeeTiers(value string) ([]CancellationFeeTier, error) {
+	var tiers []CancellationFeeTier
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		daysStr, percentStr, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("キャンセル料の段階の形式が正しくありません: %s", part)
+		}
+		days, err := strconv.Atoi(strings.TrimSpace(daysStr))
+		if err != nil || days < 0 {
+			return nil, fmt.Errorf("キャンセル料の日数が正しくありません: %s", part)
+		}
+		percent, err := strconv.ParseFloat(strings.TrimSpace(percentStr), 64)
+		if err != nil || percent < 0 || percent > 100 {
+			return nil, fmt.Errorf("キャンセル料の料率が正しくありません: %s", part)
+		}
+
+		tiers = append(tiers, CancellationFeeTier{MinDaysBefore: days, FeePercent: percent})
+	}
+
+	if len(tiers) == 0 {
+		return nil, fmt.Errorf("キャンセル料の段階が指定されていません")
+	}
+	return tiers, nil
+}
+
+// DaysBefore キャンセル日から利用日までの日数（暦日）
+// 当日は0、利用開始後は負の値になる
+func (p CancellationPolicy) DaysBefore(startTime, cancelledAt time.Time) int {
+	start := startTime.In(p.Location)
+	at := cancelledAt.In(p.Location)
+	startDate := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	atDate := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	return int(startDate.Sub(atDate).Hours() / 24)
+}
+
+// FeePercent 予約タイプとキャンセル日時に応じたキャンセル料率
+func (p CancellationPolicy) FeePercent(bookingType models.BookingType, startTime, cancelledAt time.Time) float64 {
+	tiers := p.Tiers[bookingType]
+	if len(tiers) == 0 {
+		return 0
+	}
+
+	days := p.DaysBefore(startTime, cancelledAt)
+	for _, tier := range tiers {
+		if days >= tier.MinDaysBefore {
+			return tier.FeePercent
+		}
+	}
+	// 利用開始後は最も高い段階を適用する
+	return tiers[len(tiers)-1].FeePercent
+}
+
+// Quote 予約をキャンセルした場合のキャンセル料を見積もる
+// baseAmountは予約時間分の利用料（税抜）
+func (p CancellationPolicy) Quote(booking models.Booking, baseAmount int, cancelledAt time.Time) CancellationQuote {
+	percent := p.FeePercent(booking.BookingType, booking.StartTime, cancelledAt)
+
+	return CancellationQuote{
+		BookingID:       booking.ID.String(),
+		BookingType:     string(booking.BookingType),
+		DaysBeforeStart: p.DaysBefore(booking.StartTime, cancelledAt),
+		FeePercent:      percent,
+		BaseAmount:      baseAmount,
+		FeeAmount:       int(math.Floor(float64(baseAmount) * percent / 100)),
+		QuotedAt:        cancelledAt,
+	}
+}
+
+// CancellationQuote はキャンセル料の見積もり
+type CancellationQuote struct {
+	BookingID       string    `json:"bookingId"`
+	BookingType     string    `json:"bookingType"`
+	DaysBeforeStart int       `json:"daysBeforeStart"`
+	FeePercent      float64   `json:"feePercent"`
+	BaseAmount      int       `json:"baseAmount"`
+	FeeAmount       int       `json:"feeAmount"`
+	QuotedAt        time.Time `json:"quotedAt"`
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+)
+
+var cancellationLocation = time.FixedZone("JST", 9*60*60)
+
+func TestCancellationPolicyFeePercent(t *testing.T) {
+	confirmed, err := ParseCancellationFeeTiers("6:0,4:50,1:80,0:100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 順不同で指定しても日数の降順に並べ替えられる
+	temporary, err := ParseCancellationFeeTiers("0:30,3:0,1:10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := NewCancellationPolicy(map[models.BookingType][]CancellationFeeTier{
+		models.BookingTypeConfirmed: confirmed,
+		models.BookingTypeTemporary: temporary,
+	}, cancellationLocation)
+
+	start := time.Date(2025, 4, 10, 10, 0, 0, 0, cancellationLocation)
+	daysBefore := func(days int) time.Time {
+		return start.AddDate(0, 0, -days)
+	}
+
+	tests := []struct {
+		name        string
+		bookingType models.BookingType
+		cancelledAt time.Time
+		want        float64
+	}{
+		{"confirmed 7 days before", models.BookingTypeConfirmed, daysBefore(7), 0},
+		{"confirmed 6 days before", models.BookingTypeConfirmed, daysBefore(6), 0},
+		{"confirmed 5 days before", models.BookingTypeConfirmed, daysBefore(5), 50},
+		{"confirmed 4 days before", models.BookingTypeConfirmed, daysBefore(4), 50},
+		{"confirmed 3 days before", models.BookingTypeConfirmed, daysBefore(3), 80},
+		{"confirmed 1 day before", models.BookingTypeConfirmed, daysBefore(1), 80},
+		{"confirmed same day", models.BookingTypeConfirmed, start.Add(-9 * time.Hour), 100},
+		{"confirmed after start", models.BookingTypeConfirmed, start.Add(2 * time.Hour), 100},
+		{"confirmed next day", models.BookingTypeConfirmed, start.AddDate(0, 0, 1), 100},
+		// 暦日で数えるため、前日の深夜でも1日前として扱う
+		{"confirmed day before late night", models.BookingTypeConfirmed, time.Date(2025, 4, 9, 23, 59, 0, 0, cancellationLocation), 80},
+		// UTCでは前日でも、スタジオのタイムゾーンで当日であれば当日として数える
+		{"confirmed same day in studio timezone", models.BookingTypeConfirmed, time.Date(2025, 4, 9, 16, 0, 0, 0, time.UTC), 100},
+		{"temporary 3 days before", models.BookingTypeTemporary, daysBefore(3), 0},
+		{"temporary 2 days before", models.BookingTypeTemporary, daysBefore(2), 10},
+		{"temporary 1 day before", models.BookingTypeTemporary, daysBefore(1), 10},
+		{"temporary same day", models.BookingTypeTemporary, start.Add(-time.Hour), 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.FeePercent(tt.bookingType, start, tt.cancelledAt); got != tt.want {
+				t.Fatalf("expected %g%%, got %g%%", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCancellationPolicyWithoutTiers(t *testing.T) {
+	confirmed, err := ParseCancellationFeeTiers("6:0,0:100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := NewCancellationPolicy(map[models.BookingType][]CancellationFeeTier{
+		models.BookingTypeConfirmed: confirmed,
+	}, cancellationLocation)
+
+	start := time.Date(2025, 4, 10, 10, 0, 0, 0, cancellationLocation)
+	if got := policy.FeePercent(models.BookingTypeTemporary, start, start.Add(-time.Hour)); got != 0 {
+		t.Fatalf("expected no fee for a type without tiers, got %g%%", got)
+	}
+}
+
+func TestCancellationPolicyQuote(t *testing.T) {
+	confirmed, err := ParseCancellationFeeTiers("6:0,4:50,1:80,0:100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := NewCancellationPolicy(map[models.BookingType][]CancellationFeeTier{
+		models.BookingTypeConfirmed: confirmed,
+	}, cancellationLocation)
+
+	booking := models.Booking{
+		ID:          uuid.New(),
+		StartTime:   time.Date(2025, 4, 10, 10, 0, 0, 0, cancellationLocation),
+		BookingType: models.BookingTypeConfirmed,
+	}
+	quote := policy.Quote(booking, 10001, booking.StartTime.AddDate(0, 0, -2))
+
+	if quote.DaysBeforeStart != 2 || quote.FeePercent != 80 {
+		t.Fatalf("expected 80%% two days before, got %g%% %d days before", quote.FeePercent, quote.DaysBeforeStart)
+	}
+	// 円未満は切り捨てる
+	if quote.FeeAmount != 8000 {
+		t.Fatalf("expected fee 8000, got %d", quote.FeeAmount)
+	}
+}
+
+func TestParseCancellationFeeTiers(t *testing.T) {
+	for _, value := range []string{"", "6", "a:10", "-1:10", "3:101", "3:-5"} {
+		if _, err := ParseCancellationFeeTiers(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+	tiers, err := ParseCancellationFeeTiers(" 6:0 , 0:100 ,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tiers) != 2 || tiers[1] != (CancellationFeeTier{MinDaysBefore: 0, FeePercent: 100}) {
+		t.Fatalf("unexpected tiers %+v", tiers)
+	}
+}
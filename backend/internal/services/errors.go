@@ -0,0 +1,135 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/zebraApp/internal/i18n"
+	"github.com/zebraApp/internal/validation"
+)
+
+// ErrorKind はエラーの種類（HTTPステータスに対応する）
+type ErrorKind int
+
+const (
+	// KindValidation はリクエスト内容の誤り（400）
+	KindValidation ErrorKind = iota + 1
+	// KindUnauthorized は認証の失敗（401）
+	KindUnauthorized
+	// KindForbidden は権限不足（403）
+	KindForbidden
+	// KindNotFound は対象が存在しない（404）
+	KindNotFound
+	// KindConflict は現在の状態と競合する操作（409）
+	KindConflict
+)
+
+// Error はクライアントに返すことを前提としたエラー
+// Codeはフロントエンドと共有するエラーコード（ERROR_CODES）、
+// MessageKeyはi18nのメッセージキーで、言語はレスポンス時に決まる
+type Error struct {
+	Kind       ErrorKind
+	Code       string
+	MessageKey string
+	Args       []interface{}
+	Details    map[string]interface{}
+	// base はWithMessage等で派生させた元のエラー（errors.Isで元のエラーと判定できるようにする）
+	base *Error
+}
+
+func newError(kind ErrorKind, code, key string, args ...interface{}) *Error {
+	return &Error{Kind: kind, Code: code, MessageKey: key, Args: args}
+}
+
+// NewValidationError リクエスト内容の誤りを表すエラーを作成する
+func NewValidationError(code, key string, args ...interface{}) *Error {
+	return newError(KindValidation, code, key, args...)
+}
+
+// NewUnauthorizedError 認証の失敗を表すエラーを作成する
+func NewUnauthorizedError(code, key string, args ...interface{}) *Error {
+	return newError(KindUnauthorized, code, key, args...)
+}
+
+// NewForbiddenError 権限不足を表すエラーを作成する
+func NewForbiddenError(code, key string, args ...interface{}) *Error {
+	return newError(KindForbidden, code, key, args...)
+}
+
+// NewNotFoundError 対象が存在しないことを表すエラーを作成する
+func NewNotFoundError(code, key string, args ...interface{}) *Error {
+	return newError(KindNotFound, code, key, args...)
+}
+
+// NewConflictError 現在の状態と競合する操作を表すエラーを作成する
+func NewConflictError(code, key string, args ...interface{}) *Error {
+	return newError(KindConflict, code, key, args...)
+}
+
+// Error 既定の言語のメッセージを返す（ログ用）
+func (e *Error) Error() string {
+	return i18n.Message(i18n.Default, e.MessageKey, e.Args...)
+}
+
+// Unwrap 派生元のエラーを返す
+func (e *Error) Unwrap() error {
+	if e.base == nil {
+		return nil
+	}
+	return e.base
+}
+
+// Message 指定言語のメッセージ
+func (e *Error) Message(lang i18n.Lang) string {
+	return i18n.Message(lang, e.MessageKey, e.Args...)
+}
+
+// WithMessage メッセージだけを差し替えたエラーを返す
+// errors.Is(err, e)は引き続き真になる
+func (e *Error) WithMessage(key string, args ...interface{}) *Error {
+	derived := e.derive()
+	derived.MessageKey = key
+	derived.Args = args
+	return derived
+}
+
+// WithDetails レスポンスのdetailsに含める情報を付加したエラーを返す
+func (e *Error) WithDetails(details map[string]interface{}) *Error {
+	derived := e.derive()
+	derived.Details = details
+	return derived
+}
+
+func (e *Error) derive() *Error {
+	derived := *e
+	derived.base = e
+	return &derived
+}
+
+// AsError エラーをクライアントに返す形式に変換する
+// 変換できないエラー（データベースエラーなど）の場合はfalseを返す
+func AsError(err error) (*Error, bool) {
+	var conflict *BookingConflictError
+	if errors.As(err, &conflict) {
+		return ErrTimeSlotUnavailable.WithDetails(map[string]interface{}{
+			"conflictingBookingIds": conflict.ConflictingBookingIDs,
+		}), true
+	}
+
+	var violation *validation.Error
+	if errors.As(err, &violation) && len(violation.Violations) > 0 {
+		first := violation.Violations[0]
+		return &Error{
+			Kind:       KindValidation,
+			Code:       string(first.Code),
+			MessageKey: first.MessageKey(),
+			Args:       first.MessageArgs(),
+			Details:    map[string]interface{}{"violations": violation.Violations},
+		}, true
+	}
+
+	var typed *Error
+	if errors.As(err, &typed) {
+		return typed, true
+	}
+	return nil, false
+}
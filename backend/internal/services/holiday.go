@@ -0,0 +1,178 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/holiday"
+	"github.com/zebraApp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrHolidayNotFound は独自の休日が存在しないことを表す
+	ErrHolidayNotFound = NewNotFoundError("NOT_FOUND", "holiday.not_found")
+	// ErrInvalidHoliday は休日の内容が不正であることを表す
+	ErrInvalidHoliday = NewValidationError("INVALID_PARAMETERS", "code.INVALID_PARAMETERS")
+)
+
+// 休日の出所
+const (
+	holidaySourceNational = "national"
+	holidaySourceCustom   = "custom"
+)
+
+// HolidayServiceImpl は日本の祝日と独自に登録した休日を合わせた祝日カレンダーを提供する
+type HolidayServiceImpl struct {
+	db    *gorm.DB
+	japan *holiday.Japan
+}
+
+func NewHolidayService(db *gorm.DB) *HolidayServiceImpl {
+	return &HolidayServiceImpl{
+		db:    db,
+		japan: holiday.NewJapan(),
+	}
+}
+
+// Holidays 祝日カレンダーを取得する（holiday.Sourceの実装）
+// 独自の休日と国民の祝日が同じ日の場合は独自の休日名を使う
+func (s *HolidayServiceImpl) Holidays() (holiday.Provider, error) {
+	custom, err := s.customHolidays()
+	if err != nil {
+		return nil, err
+	}
+	return holiday.Merge(custom, s.japan), nil
+}
+
+// GetHolidays 指定年の祝日一覧取得
+func (s *HolidayServiceImpl) GetHolidays(year int) ([]HolidayResponse, error) {
+	var rows []models.Holiday
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	if err := s.db.Where("date >= ? AND date < ?", start, end).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("休日の取得に失敗しました: %w", err)
+	}
+
+	customIDs := make(map[string]string, len(rows))
+	holidays := make([]holiday.Holiday, len(rows))
+	for i, row := range rows {
+		customIDs[row.Date.Format("2006-01-02")] = row.ID.String()
+		holidays[i] = holiday.Holiday{Date: row.Date, Name: row.Name}
+	}
+
+	merged := holiday.Merge(holiday.NewList(holidays), s.japan)
+	responses := []HolidayResponse{}
+	for _, h := range holiday.Between(merged, start, end) {
+		date := h.Date.Format("2006-01-02")
+		response := HolidayResponse{Date: date, Name: h.Name, Source: holidaySourceNational}
+		if id, ok := customIDs[date]; ok {
+			response.ID = id
+			response.Source = holidaySourceCustom
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// ImportHolidays 独自の休日一覧を取り込み、取り込んだ件数を返す
+// 同じ日付の休日は名前を上書きする。replaceの場合は既存の休日をすべて置き換える
+func (s *HolidayServiceImpl) ImportHolidays(holidays []holiday.Holiday, replace bool) (int, error) {
+	if len(holidays) == 0 && !replace {
+		return 0, ErrInvalidHoliday.WithMessage("holiday.empty")
+	}
+
+	now := time.Now()
+	rows := make([]models.Holiday, 0, len(holidays))
+	seen := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		if h.Name == "" {
+			return 0, ErrInvalidHoliday.WithMessage("holiday.name_required")
+		}
+		key := h.Date.Format("2006-01-02")
+		if seen[key] {
+			return 0, ErrInvalidHoliday.WithMessage("holiday.duplicate_date", key)
+		}
+		seen[key] = true
+
+		rows = append(rows, models.Holiday{
+			ID:        uuid.New(),
+			Date:      time.Date(h.Date.Year(), h.Date.Month(), h.Date.Day(), 0, 0, 0, 0, time.UTC),
+			Name:      h.Name,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if replace {
+			if err := tx.Where("1 = 1").Delete(&models.Holiday{}).Error; err != nil {
+				return fmt.Errorf("既存の休日の削除に失敗しました: %w", err)
+			}
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "updated_at"}),
+		}).Create(&rows).Error; err != nil {
+			return fmt.Errorf("休日の登録に失敗しました: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(rows), nil
+}
+
+// DeleteHoliday 独自の休日を削除する
+func (s *HolidayServiceImpl) DeleteHoliday(holidayID string) error {
+	if _, err := uuid.Parse(holidayID); err != nil {
+		return ErrHolidayNotFound
+	}
+
+	result := s.db.Delete(&models.Holiday{}, "id = ?", holidayID)
+	if result.Error != nil {
+		return fmt.Errorf("休日の削除に失敗しました: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrHolidayNotFound
+	}
+	return nil
+}
+
+// customHolidays 独自に登録した休日の一覧
+func (s *HolidayServiceImpl) customHolidays() (holiday.List, error) {
+	var rows []models.Holiday
+	if err := s.db.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("休日の取得に失敗しました: %w", err)
+	}
+
+	holidays := make([]holiday.Holiday, len(rows))
+	for i, row := range rows {
+		holidays[i] = holiday.Holiday{Date: row.Date, Name: row.Name}
+	}
+	return holiday.NewList(holidays), nil
+}
+
+// コントローラーと共有するリクエスト・レスポンス型
+type HolidayImportRequest struct {
+	Holidays []HolidayRequest `json:"holidays"`
+	Replace  bool             `json:"replace"` // trueの場合は既存の休日をすべて置き換える
+}
+
+type HolidayRequest struct {
+	Date string `json:"date"` // YYYY-MM-DD
+	Name string `json:"name"`
+}
+
+type HolidayResponse struct {
+	ID     string `json:"id,omitempty"` // 独自の休日のみ
+	Date   string `json:"date"`
+	Name   string `json:"name"`
+	Source string `json:"source"` // national, custom
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrKeepLimitExceeded は同一時間帯のキープ数が上限に達していることを表す
+	ErrKeepLimitExceeded = NewConflictError("KEEP_LIMIT_EXCEEDED", "booking.keep_limit_exceeded")
+	// ErrTimeSlotHeld は指定時間帯が他の予約の変更申請で仮押さえされていることを表す
+	ErrTimeSlotHeld = NewConflictError("TIME_SLOT_UNAVAILABLE", "booking.time_slot_held")
+)
+
+// activeBookingStatuses は時間枠を占有する予約ステータス
+var activeBookingStatuses = []models.BookingStatus{
+	models.BookingStatusPending,
+	models.BookingStatusApproved,
+}
+
+// KeepQueueServiceImpl は同一時間帯に重なる仮予約のキープ順序を管理する
+// 第一予約が確定しなかった場合に備え、第三キープまで順番待ちを受け付ける
+type KeepQueueServiceImpl struct {
+	db *gorm.DB
+}
+
+func NewKeepQueueService(db *gorm.DB) *KeepQueueServiceImpl {
+	return &KeepQueueServiceImpl{db: db}
+}
+
+// AssignKeepOrder 新しく時間枠を占有する予約のキープ順序を決定する
+// 重複する予約がなければ第一予約、仮予約であれば後ろに並ぶ。本予約は順番待ちできない。
+// 他の予約の変更申請で仮押さえされている時間帯は、キープも受け付けない。
+// 呼び出し元のトランザクション内で実行し、重複する予約の行をロックする
+func (q *KeepQueueServiceImpl) AssignKeepOrder(tx *gorm.DB, startTime, endTime time.Time, bookingType models.BookingType, excludeBookingID string) (int, error) {
+	if err := lockSlotHolds(tx); err != nil {
+		return 0, err
+	}
+	overlapping, err := lockOverlappingBookings(tx, startTime, endTime, excludeBookingID)
+	if err != nil {
+		return 0, err
+	}
+	if err := ensureSlotNotHeld(tx, startTime, endTime, excludeBookingID); err != nil {
+		return 0, err
+	}
+
+	if len(overlapping) == 0 {
+		return models.KeepOrderFirst, nil
+	}
+
+	if bookingType != models.BookingTypeTemporary {
+		ids := make([]string, len(overlapping))
+		for i, b := range overlapping {
+			ids[i] = b.ID.String()
+		}
+		return 0, &BookingConflictError{ConflictingBookingIDs: ids}
+	}
+
+	maxOrder := 0
+	for _, b := range overlapping {
+		if b.KeepOrder > maxOrder {
+			maxOrder = b.KeepOrder
+		}
+	}
+	if maxOrder >= models.MaxKeepCount {
+		return 0, ErrKeepLimitExceeded
+	}
+
+	return maxOrder + 1, nil
+}
+
+// PromoteAfterRelease 予約が時間枠を明け渡した後に、後ろのキープを繰り上げる
+// releasedは明け渡し前の状態の予約。繰り上げのたびにステータスログを記録する
+// 繰り上げる予約は明け渡された時間帯以外にも他の予約と重なることがあるため、
+// 繰り上げ先は予約自身の時間帯に重なるすべての予約を見て決める
+func (q *KeepQueueServiceImpl) PromoteAfterRelease(tx *gorm.DB, released models.Booking, changedBy *uuid.UUID) ([]models.Booking, error) {
+	overlapping, err := lockOverlappingBookings(tx, released.StartTime, released.EndTime, released.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var promoted []models.Booking
+	now := time.Now()
+
+	// 制約違反を避けるため、キープ順序の小さいものから繰り上げる
+	for _, b := range overlapping {
+		if b.KeepOrder <= released.KeepOrder {
+			continue
+		}
+
+		// 先に繰り上げた予約の新しい順序も反映された状態で、重複するすべての予約をロックして取得する
+		neighbours, err := lockOverlappingBookings(tx, b.StartTime, b.EndTime, b.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		newOrder := promotedKeepOrder(b, neighbours)
+		if newOrder == b.KeepOrder {
+			continue
+		}
+
+		if err := tx.Model(&models.Booking{}).Where("id = ?", b.ID).
+			Updates(map[string]interface{}{
+				"keep_order": newOrder,
+				"updated_at": now,
+			}).Error; err != nil {
+			return nil, fmt.Errorf("キープ順序の繰り上げに失敗しました: %w", err)
+		}
+
+		statusLog := models.BookingStatusLog{
+			ID:             uuid.New(),
+			BookingID:      b.ID,
+			PreviousStatus: b.Status,
+			NewStatus:      b.Status,
+			ChangedBy:      changedBy,
+			ChangedAt:      now,
+			Note:           fmt.Sprintf("キープ繰り上げ: %sから%sへ", keepOrderLabel(b.KeepOrder), keepOrderLabel(newOrder)),
+		}
+		if err := tx.Create(&statusLog).Error; err != nil {
+			return nil, fmt.Errorf("ステータスログの記録に失敗しました: %w", err)
+		}
+
+		b.KeepOrder = newOrder
+		promoted = append(promoted, b)
+	}
+
+	return promoted, nil
+}
+
+// promotedKeepOrder 予約を繰り上げられる最小のキープ順序を返す
+// overlappingは予約と重複する他の有効な予約。空いている順序がなければ現在の順序のまま
+func promotedKeepOrder(booking models.Booking, overlapping []models.Booking) int {
+	used := make(map[int]bool, len(overlapping))
+	for _, b := range overlapping {
+		used[b.KeepOrder] = true
+	}
+	for order := models.KeepOrderFirst; order < booking.KeepOrder; order++ {
+		if !used[order] {
+			return order
+		}
+	}
+	return booking.KeepOrder
+}
+
+// EnsureFirstKeep 予約が時間帯の第一予約であることを確認する
+// 呼び出し元のトランザクション内で実行し、重複する予約の行をロックする
+func (q *KeepQueueServiceImpl) EnsureFirstKeep(tx *gorm.DB, booking models.Booking) error {
+	if booking.KeepOrder != models.KeepOrderFirst {
+		return ErrNotFirstKeep
+	}
+
+	overlapping, err := lockOverlappingBookings(tx, booking.StartTime, booking.EndTime, booking.ID.String())
+	if err != nil {
+		return err
+	}
+	for _, b := range overlapping {
+		if b.KeepOrder <= booking.KeepOrder {
+			return ErrNotFirstKeep
+		}
+	}
+	return nil
+}
+
+// lockOverlappingBookings 指定時間帯と重複する有効な予約をキープ順に取得し、行をロックする
+func lockOverlappingBookings(tx *gorm.DB, startTime, endTime time.Time, excludeBookingID string) ([]models.Booking, error) {
+	query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("start_time < ? AND end_time > ? AND status IN ?", endTime, startTime, activeBookingStatuses)
+
+	if excludeBookingID != "" {
+		query = query.Where("id != ?", excludeBookingID)
+	}
+
+	var bookings []models.Booking
+	if err := query.Order("keep_order ASC").Find(&bookings).Error; err != nil {
+		return nil, fmt.Errorf("重複する予約の取得に失敗しました: %w", err)
+	}
+	return bookings, nil
+}
+
+// slotHoldLockKey は予約の時間枠の確保と変更申請の仮押さえを直列化するアドバイザリロックのキー
+const slotHoldLockKey int64 = 0x7a656272615f736c // "zebra_sl"
+
+// lockSlotHolds 予約の時間枠の確保と変更申請の仮押さえを直列化する
+// 仮押さえと予約は別のテーブルにあり排他制約では重複を防げないため、確認から作成までをトランザクション単位のロックで保護する。
+// 行ロックとの待ち合わせを避けるため、重複する予約をロックする前に取得する
+func lockSlotHolds(tx *gorm.DB) error {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", slotHoldLockKey).Error; err != nil {
+		return fmt.Errorf("時間枠のロックに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// ensureSlotNotHeld 指定時間帯が他の予約の変更申請で仮押さえされていないことを確認する
+// excludeBookingIDの予約自身の変更申請は対象外とする。確認後に予約・仮押さえを作成する場合はlockSlotHoldsを先に取得しておく
+func ensureSlotNotHeld(tx *gorm.DB, startTime, endTime time.Time, excludeBookingID string) error {
+	query := tx.Model(&models.BookingChangeRequest{}).
+		Where("status = ? AND start_time < ? AND end_time > ?", models.ChangeRequestStatusPending, endTime, startTime)
+
+	if excludeBookingID != "" {
+		query = query.Where("booking_id != ?", excludeBookingID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return fmt.Errorf("仮押さえの確認に失敗しました: %w", err)
+	}
+	if count > 0 {
+		return ErrTimeSlotHeld
+	}
+	return nil
+}
+
+// isActiveStatus 時間枠を占有するステータスかどうか
+func isActiveStatus(status models.BookingStatus) bool {
+	return status.IsActive()
+}
+
+// keepOrderLabel キープ順序の表示名
+func keepOrderLabel(order int) string {
+	switch order {
+	case 1:
+		return "第一予約"
+	case 2:
+		return "第二キープ"
+	case 3:
+		return "第三キープ"
+	default:
+		return fmt.Sprintf("第%dキープ", order)
+	}
+}
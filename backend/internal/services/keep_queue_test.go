@@ -0,0 +1,98 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zebraApp/internal/models"
+)
+
+// keepBooking 指定の時刻（時）とキープ順序の予約
+func keepBooking(startHour, endHour, keepOrder int) models.Booking {
+	day := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	return models.Booking{
+		StartTime: day.Add(time.Duration(startHour) * time.Hour),
+		EndTime:   day.Add(time.Duration(endHour) * time.Hour),
+		Status:    models.BookingStatusPending,
+		KeepOrder: keepOrder,
+	}
+}
+
+// overlappingWith bookingsのうちbookingと時間帯が重なるもの
+func overlappingWith(booking models.Booking, bookings []models.Booking) []models.Booking {
+	var result []models.Booking
+	for _, b := range bookings {
+		if b.StartTime.Before(booking.EndTime) && b.EndTime.After(booking.StartTime) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+func TestPromotedKeepOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		booking models.Booking
+		others  []models.Booking
+		want    int
+	}{
+		{"no other bookings", keepBooking(10, 12, 2), nil, 1},
+		{"first keep stays", keepBooking(10, 12, 1), nil, 1},
+		// Bは明け渡された時間帯の外でCと重なり、Cが第一予約のまま
+		{"chained overlap keeps order", keepBooking(11, 13, 2), []models.Booking{keepBooking(12, 14, 1)}, 2},
+		{"chained overlap promotes to free order", keepBooking(11, 13, 3), []models.Booking{keepBooking(12, 14, 1)}, 2},
+		{"lower orders taken on both sides", keepBooking(11, 13, 3), []models.Booking{keepBooking(10, 12, 2), keepBooking(12, 14, 1)}, 3},
+		{"skips gap below taken order", keepBooking(11, 13, 3), []models.Booking{keepBooking(10, 12, 1)}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := promotedKeepOrder(tt.booking, tt.others); got != tt.want {
+				t.Fatalf("expected keep order %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestPromotedKeepOrderChainedRelease 第一予約の明け渡し後に、後ろのキープを順に繰り上げても
+// 重なる予約同士のキープ順序が重複しないことを確かめる
+func TestPromotedKeepOrderChainedRelease(t *testing.T) {
+	// A(10-12, 第一)を明け渡す。B(11-13, 第二)はC(12-14, 第一)とも重なり、D(10-11, 第二)はAとだけ重なる
+	a := keepBooking(10, 12, 1)
+	b := keepBooking(11, 13, 2)
+	c := keepBooking(12, 14, 1)
+	d := keepBooking(10, 11, 2)
+	remaining := []*models.Booking{&d, &b, &c}
+
+	for _, candidate := range overlappingWith(a, []models.Booking{d, b}) {
+		var target *models.Booking
+		var others []models.Booking
+		for _, r := range remaining {
+			if r.StartTime.Equal(candidate.StartTime) && r.EndTime.Equal(candidate.EndTime) {
+				target = r
+				continue
+			}
+			others = append(others, *r)
+		}
+		target.KeepOrder = promotedKeepOrder(*target, overlappingWith(*target, others))
+	}
+
+	if b.KeepOrder != 2 {
+		t.Errorf("B overlaps first keep C and must stay second keep, got %d", b.KeepOrder)
+	}
+	if d.KeepOrder != 1 {
+		t.Errorf("D only overlapped A and should become first keep, got %d", d.KeepOrder)
+	}
+
+	// 重なる予約同士でキープ順序が重複していないこと（bookings_no_overlap制約）
+	all := []models.Booking{b, c, d}
+	for i := range all {
+		for j := i + 1; j < len(all); j++ {
+			x, y := all[i], all[j]
+			if x.StartTime.Before(y.EndTime) && x.EndTime.After(y.StartTime) && x.KeepOrder == y.KeepOrder {
+				t.Errorf("overlapping bookings share keep order %d: %v-%v and %v-%v",
+					x.KeepOrder, x.StartTime, x.EndTime, y.StartTime, y.EndTime)
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"gorm.io/gorm"
+)
+
+// notifyBookingUser 予約者への通知を作成する
+// 予約者が削除されている場合は何もしない
+func notifyBookingUser(tx *gorm.DB, booking models.Booking, notificationType models.NotificationType, title, content string) error {
+	if booking.UserID == nil {
+		return nil
+	}
+
+	bookingID := booking.ID
+	notification := models.Notification{
+		ID:              uuid.New(),
+		UserID:          *booking.UserID,
+		Title:           title,
+		Content:         content,
+		Type:            notificationType,
+		RelatedEntityID: &bookingID,
+		CreatedAt:       time.Now(),
+	}
+	if err := tx.Create(&notification).Error; err != nil {
+		return fmt.Errorf("通知の作成に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// notifyAdmins 管理者全員への通知を作成する
+// relatedEntityIDには通知の対象（予約など）のIDを指定する
+func notifyAdmins(tx *gorm.DB, relatedEntityID uuid.UUID, title, content string) error {
+	var adminIDs []uuid.UUID
+	if err := tx.Model(&models.User{}).Where("is_admin = ?", true).Pluck("id", &adminIDs).Error; err != nil {
+		return fmt.Errorf("管理者の取得に失敗しました: %w", err)
+	}
+
+	now := time.Now()
+	for _, adminID := range adminIDs {
+		notification := models.Notification{
+			ID:              uuid.New(),
+			UserID:          adminID,
+			Title:           title,
+			Content:         content,
+			Type:            models.NotificationTypeBooking,
+			RelatedEntityID: &relatedEntityID,
+			CreatedAt:       now,
+		}
+		if err := tx.Create(&notification).Error; err != nil {
+			return fmt.Errorf("通知の作成に失敗しました: %w", err)
+		}
+	}
+	return nil
+}
+
+// formatBookingPeriod 通知文面に使う予約日時の表記
+func formatBookingPeriod(booking models.Booking) string {
+	return formatPeriod(booking.StartTime, booking.EndTime)
+}
+
+// formatPeriod 通知文面に使う日時の範囲の表記
+func formatPeriod(startTime, endTime time.Time) string {
+	return fmt.Sprintf("%s〜%s",
+		startTime.Format("2006/01/02 15:04"),
+		endTime.Format("15:04"))
+}
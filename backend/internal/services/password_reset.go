@@ -0,0 +1,162 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/mailer"
+	"github.com/zebraApp/internal/models"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrInvalidResetToken は存在しない・使用済み・期限切れのリセットトークンを表す
+var ErrInvalidResetToken = NewValidationError("INVALID_TOKEN", "password_reset.invalid_token")
+
+type PasswordResetServiceImpl struct {
+	db         *gorm.DB
+	mailer     mailer.Mailer
+	appBaseURL string
+	tokenTTL   time.Duration
+}
+
+func NewPasswordResetService(db *gorm.DB, m mailer.Mailer, appBaseURL string, tokenTTL time.Duration) *PasswordResetServiceImpl {
+	return &PasswordResetServiceImpl{
+		db:         db,
+		mailer:     m,
+		appBaseURL: strings.TrimRight(appBaseURL, "/"),
+		tokenTTL:   tokenTTL,
+	}
+}
+
+// RequestReset リセットトークンを発行してメールで送信する
+// ユーザーの存在有無を外部に漏らさないため、未登録のメールアドレスでもエラーにしない
+func (s *PasswordResetServiceImpl) RequestReset(email string) error {
+	var user models.User
+	if err := s.db.First(&user, "email = ?", normalizeEmail(email)).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("ユーザーの取得に失敗しました: %w", err)
+	}
+
+	token, err := generateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 未使用の古いトークンは無効化し、常に最新の1件のみ有効にする
+		if err := tx.Model(&models.PasswordResetToken{}).
+			Where("user_id = ? AND used_at IS NULL", user.ID).
+			Update("used_at", now).Error; err != nil {
+			return fmt.Errorf("既存のリセットトークンの無効化に失敗しました: %w", err)
+		}
+
+		resetToken := models.PasswordResetToken{
+			ID:        uuid.New(),
+			UserID:    user.ID,
+			TokenHash: hashToken(token),
+			ExpiresAt: now.Add(s.tokenTTL),
+			CreatedAt: now,
+		}
+		if err := tx.Create(&resetToken).Error; err != nil {
+			return fmt.Errorf("リセットトークンの保存に失敗しました: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 送信の失敗をエラーにすると登録済みのメールアドレスだけ応答が変わるため、ログに記録するのみとする
+	if err := s.mailer.Send(s.buildResetMessage(user, token)); err != nil {
+		log.Printf("リセットメールの送信に失敗しました: user=%s: %v", user.ID, err)
+	}
+	return nil
+}
+
+// ResetPassword トークンを検証してパスワードを更新する
+// トークンは一度しか使用できず、更新後は全てのリフレッシュトークンを無効化する
+// 無効なトークンでハッシュ化の負荷をかけられないよう、トークンを確認してからハッシュ化する
+func (s *PasswordResetServiceImpl) ResetPassword(token, newPassword string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var resetToken models.PasswordResetToken
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&resetToken, "token_hash = ?", hashToken(token)).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidResetToken
+			}
+			return fmt.Errorf("リセットトークンの取得に失敗しました: %w", err)
+		}
+
+		now := time.Now()
+		if resetToken.UsedAt != nil || now.After(resetToken.ExpiresAt) {
+			return ErrInvalidResetToken
+		}
+
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcryptCost)
+		if err != nil {
+			return fmt.Errorf("パスワードのハッシュ化に失敗しました: %w", err)
+		}
+
+		if err := tx.Model(&models.User{}).Where("id = ?", resetToken.UserID).
+			Updates(map[string]interface{}{
+				"hashed_password": string(hashedPassword),
+				"updated_at":      now,
+			}).Error; err != nil {
+			return fmt.Errorf("パスワードの更新に失敗しました: %w", err)
+		}
+
+		if err := tx.Model(&resetToken).Update("used_at", now).Error; err != nil {
+			return fmt.Errorf("リセットトークンの更新に失敗しました: %w", err)
+		}
+
+		if err := tx.Model(&models.RefreshToken{}).
+			Where("user_id = ? AND revoked_at IS NULL", resetToken.UserID).
+			Update("revoked_at", now).Error; err != nil {
+			return fmt.Errorf("リフレッシュトークンの無効化に失敗しました: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// buildResetMessage リセットメールの本文を生成
+func (s *PasswordResetServiceImpl) buildResetMessage(user models.User, token string) mailer.Message {
+	link := fmt.Sprintf("%s/reset-password?token=%s", s.appBaseURL, url.QueryEscape(token))
+
+	body := fmt.Sprintf(`%s 様
+
+パスワードリセットのリクエストを受け付けました。
+以下のリンクから新しいパスワードを設定してください。
+
+%s
+
+このリンクの有効期限は%d分です。
+お心当たりがない場合は、このメールを破棄してください。
+`, user.FullName, link, int(s.tokenTTL.Minutes()))
+
+	return mailer.Message{
+		To:      user.Email,
+		Subject: "【撮影スタジオ予約】パスワードリセットのご案内",
+		Body:    body,
+	}
+}
+
+// コントローラーと共有するリクエスト型
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"newPassword" validate:"required,min=8"`
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/pricing"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrRatePlanNotFound は料金プランが存在しないことを表す
+	ErrRatePlanNotFound = NewNotFoundError("NOT_FOUND", "rate_plan.not_found")
+	// ErrInvalidRatePlan は料金プランの内容が不正であることを表す
+	ErrInvalidRatePlan = NewValidationError("INVALID_PARAMETERS", "code.INVALID_PARAMETERS")
+)
+
+// clockLayout は料金プランの時刻表記（24:00は終了時刻のみ許可）
+const clockLayout = "15:04"
+
+// RatePlanServiceImpl は曜日・時間帯別の料金プランを管理する
+type RatePlanServiceImpl struct {
+	db *gorm.DB
+}
+
+func NewRatePlanService(db *gorm.DB) *RatePlanServiceImpl {
+	return &RatePlanServiceImpl{db: db}
+}
+
+// RateBands 有効な料金プランを料金帯として返す（pricing.RateSourceの実装）
+func (s *RatePlanServiceImpl) RateBands() ([]pricing.RateBand, error) {
+	var plans []models.RatePlan
+	if err := s.db.Where("is_active = ?", true).
+		Order("priority DESC, start_minute ASC").
+		Find(&plans).Error; err != nil {
+		return nil, fmt.Errorf("料金プランの取得に失敗しました: %w", err)
+	}
+
+	bands := make([]pricing.RateBand, len(plans))
+	for i, plan := range plans {
+		bands[i] = pricing.RateBand{
+			Name:              plan.Name,
+			WeekdayMask:       uint8(plan.WeekdayMask),
+			AppliesToHolidays: plan.AppliesToHolidays,
+			StartMinute:       plan.StartMinute,
+			EndMinute:         plan.EndMinute,
+			HourlyRate:        plan.HourlyRate,
+			Priority:          plan.Priority,
+		}
+	}
+	return bands, nil
+}
+
+// GetRatePlans 料金プラン一覧取得
+func (s *RatePlanServiceImpl) GetRatePlans() ([]RatePlanResponse, error) {
+	var plans []models.RatePlan
+	if err := s.db.Order("priority DESC, start_minute ASC").Find(&plans).Error; err != nil {
+		return nil, fmt.Errorf("料金プランの取得に失敗しました: %w", err)
+	}
+
+	responses := make([]RatePlanResponse, len(plans))
+	for i, plan := range plans {
+		responses[i] = convertToRatePlanResponse(plan)
+	}
+	return responses, nil
+}
+
+// CreateRatePlan 料金プラン作成
+func (s *RatePlanServiceImpl) CreateRatePlan(req RatePlanRequest) (*RatePlanResponse, error) {
+	plan := models.RatePlan{
+		ID:        uuid.New(),
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := applyRatePlanRequest(&plan, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Create(&plan).Error; err != nil {
+		return nil, fmt.Errorf("料金プランの作成に失敗しました: %w", err)
+	}
+
+	response := convertToRatePlanResponse(plan)
+	return &response, nil
+}
+
+// UpdateRatePlan 料金プラン更新
+func (s *RatePlanServiceImpl) UpdateRatePlan(ratePlanID string, req RatePlanRequest) (*RatePlanResponse, error) {
+	plan, err := s.findRatePlan(ratePlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyRatePlanRequest(plan, req); err != nil {
+		return nil, err
+	}
+	plan.UpdatedAt = time.Now()
+
+	if err := s.db.Save(plan).Error; err != nil {
+		return nil, fmt.Errorf("料金プランの更新に失敗しました: %w", err)
+	}
+
+	response := convertToRatePlanResponse(*plan)
+	return &response, nil
+}
+
+// DeleteRatePlan 料金プラン削除
+// 予約済みの料金はスナップショットとして保存されているため影響しない
+func (s *RatePlanServiceImpl) DeleteRatePlan(ratePlanID string) error {
+	plan, err := s.findRatePlan(ratePlanID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.Delete(plan).Error; err != nil {
+		return fmt.Errorf("料金プランの削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+func (s *RatePlanServiceImpl) findRatePlan(ratePlanID string) (*models.RatePlan, error) {
+	if _, err := uuid.Parse(ratePlanID); err != nil {
+		return nil, ErrRatePlanNotFound
+	}
+
+	var plan models.RatePlan
+	if err := s.db.First(&plan, "id = ?", ratePlanID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRatePlanNotFound
+		}
+		return nil, fmt.Errorf("料金プランの取得に失敗しました: %w", err)
+	}
+	return &plan, nil
+}
+
+// applyRatePlanRequest リクエストの内容を検証して料金プランに反映する
+func applyRatePlanRequest(plan *models.RatePlan, req RatePlanRequest) error {
+	if req.Name == "" {
+		return ErrInvalidRatePlan.WithMessage("rate_plan.name_required")
+	}
+	if len(req.DaysOfWeek) == 0 && !req.AppliesToHolidays {
+		return ErrInvalidRatePlan.WithMessage("rate_plan.days_required")
+	}
+
+	days := make([]time.Weekday, 0, len(req.DaysOfWeek))
+	for _, d := range req.DaysOfWeek {
+		if d < int(time.Sunday) || d > int(time.Saturday) {
+			return ErrInvalidRatePlan.WithMessage("rate_plan.invalid_weekday")
+		}
+		days = append(days, time.Weekday(d))
+	}
+
+	startMinute, err := parseClockMinutes(req.StartTime)
+	if err != nil || startMinute >= 24*60 {
+		return ErrInvalidRatePlan.WithMessage("rate_plan.invalid_start")
+	}
+	endMinute, err := parseClockMinutes(req.EndTime)
+	if err != nil {
+		return ErrInvalidRatePlan.WithMessage("rate_plan.invalid_end")
+	}
+	if endMinute <= startMinute {
+		return ErrInvalidRatePlan.WithMessage("rate_plan.end_before_start")
+	}
+	if req.HourlyRate < 0 {
+		return ErrInvalidRatePlan.WithMessage("rate_plan.negative_rate")
+	}
+
+	plan.Name = req.Name
+	plan.WeekdayMask = int(pricing.WeekdayMask(days...))
+	plan.AppliesToHolidays = req.AppliesToHolidays
+	plan.StartMinute = startMinute
+	plan.EndMinute = endMinute
+	plan.HourlyRate = req.HourlyRate
+	plan.Priority = req.Priority
+	if req.IsActive != nil {
+		plan.IsActive = *req.IsActive
+	}
+	return nil
+}
+
+// parseClockMinutes "HH:MM"を0時からの経過分に変換する（"24:00"は1440）
+func parseClockMinutes(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse(clockLayout, value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// formatClockMinutes 0時からの経過分を"HH:MM"に変換する
+func formatClockMinutes(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func convertToRatePlanResponse(plan models.RatePlan) RatePlanResponse {
+	days := []int{}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if plan.WeekdayMask&(1<<uint(d)) != 0 {
+			days = append(days, int(d))
+		}
+	}
+
+	return RatePlanResponse{
+		ID:                plan.ID.String(),
+		Name:              plan.Name,
+		DaysOfWeek:        days,
+		AppliesToHolidays: plan.AppliesToHolidays,
+		StartTime:         formatClockMinutes(plan.StartMinute),
+		EndTime:           formatClockMinutes(plan.EndMinute),
+		HourlyRate:        plan.HourlyRate,
+		Priority:          plan.Priority,
+		IsActive:          plan.IsActive,
+		CreatedAt:         plan.CreatedAt,
+		UpdatedAt:         plan.UpdatedAt,
+	}
+}
+
+// コントローラーと共有するリクエスト・レスポンス型
+type RatePlanRequest struct {
+	Name              string `json:"name"`
+	DaysOfWeek        []int  `json:"daysOfWeek"` // 0: 日曜 〜 6: 土曜
+	AppliesToHolidays bool   `json:"appliesToHolidays"`
+	StartTime         string `json:"startTime"` // HH:MM
+	EndTime           string `json:"endTime"`   // HH:MM（24:00可）
+	HourlyRate        int    `json:"hourlyRate"`
+	Priority          int    `json:"priority"`
+	IsActive          *bool  `json:"isActive,omitempty"`
+}
+
+type RatePlanResponse struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	DaysOfWeek        []int     `json:"daysOfWeek"`
+	AppliesToHolidays bool      `json:"appliesToHolidays"`
+	StartTime         string    `json:"startTime"`
+	EndTime           string    `json:"endTime"`
+	HourlyRate        int       `json:"hourlyRate"`
+	Priority          int       `json:"priority"`
+	IsActive          bool      `json:"isActive"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
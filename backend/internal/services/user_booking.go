@@ -0,0 +1,359 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zebraApp/internal/models"
+	"github.com/zebraApp/internal/pricing"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrBookingNotFound は予約が存在しないことを表す
+	ErrBookingNotFound = NewNotFoundError("BOOKING_NOT_FOUND", "booking.not_found")
+	// ErrNotBookingOwner は他のユーザーの予約を操作しようとしたことを表す
+	ErrNotBookingOwner = NewForbiddenError("FORBIDDEN", "booking.not_owner")
+	// ErrBookingNotCancellable はキャンセルできない状態の予約であることを表す
+	ErrBookingNotCancellable = NewConflictError("BOOKING_CANNOT_BE_CANCELLED", "booking.not_cancellable")
+	// ErrTimeSlotUnavailable は指定時間帯に既に予約があることを表す
+	ErrTimeSlotUnavailable = NewConflictError("TIME_SLOT_UNAVAILABLE", "booking.time_slot_unavailable")
+	// ErrInvalidOption は存在しない・無効なオプションが指定されたことを表す
+	ErrInvalidOption = NewValidationError("INVALID_PARAMETERS", "booking.invalid_option")
+	// ErrUserNotFound は予約対象のユーザーが存在しないことを表す
+	ErrUserNotFound = NewNotFoundError("NOT_FOUND", "booking.user_not_found")
+)
+
+type UserBookingServiceImpl struct {
+	db                 *gorm.DB
+	states             *BookingStateMachine
+	cancellationPolicy CancellationPolicy
+	pricing            *pricing.Engine
+	validator          *BookingValidatorImpl
+}
+
+func NewUserBookingService(db *gorm.DB, cancellationPolicy CancellationPolicy, pricingEngine *pricing.Engine, validator *BookingValidatorImpl) *UserBookingServiceImpl {
+	return &UserBookingServiceImpl{
+		db:                 db,
+		states:             NewBookingStateMachine(db, cancellationPolicy, pricingEngine),
+		cancellationPolicy: cancellationPolicy,
+		pricing:            pricingEngine,
+		validator:          validator,
+	}
+}
+
+// CreateBooking ユーザーによる予約申請
+// 作成された予約は常に申請中ステータスとなり、管理者の承認を待つ
+// 仮予約は既存の予約と重なっていても第三キープまで順番待ちできる
+func (s *UserBookingServiceImpl) CreateBooking(req UserCreateBookingRequest, userID string) (*BookingResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザーIDが無効です: %w", err)
+	}
+
+	now := time.Now()
+	booking := models.Booking{
+		ID:          uuid.New(),
+		UserID:      &userUUID,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Status:      models.BookingStatusPending,
+		BookingType: models.BookingType(req.BookingType),
+		Purpose:     req.Purpose,
+		PeopleCount: req.PeopleCount,
+		CreatedBy:   &userUUID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	// 仮予約には確認期限を設定する
+	setConfirmationDeadline(&booking, now)
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 業務ルールの検証（仮予約数は同時の申請と競合しないよう作成と同じトランザクションで数える）
+		if err := s.validator.ValidateBooking(tx, booking, now); err != nil {
+			return err
+		}
+
+		if err := s.states.Create(tx, &booking, BookingActorUser, "ユーザーによる予約申請"); err != nil {
+			if isBookingOverlapViolation(err) {
+				return errBookingOverlap
+			}
+			return err
+		}
+
+		for _, selected := range req.Options {
+			if err := createBookingOption(tx, booking.ID, selected); err != nil {
+				return err
+			}
+		}
+
+		if err := snapshotBookingPrice(tx, s.pricing, booking.ID); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if errors.Is(err, errBookingOverlap) {
+		return nil, newBookingConflictError(s.db, req.StartTime, req.EndTime, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response, _, err := s.GetBookingByID(booking.ID.String(), userID)
+	return response, err
+}
+
+// GetBookings ユーザー自身の予約履歴取得
+func (s *UserBookingServiceImpl) GetBookings(userID string, filters UserBookingFilters, limit, offset int) (*UserBookingListResponse, error) {
+	query := s.db.Model(&models.Booking{}).Where("user_id = ?", userID)
+
+	if filters.Status != "" && filters.Status != "all" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.BookingType != "" && filters.BookingType != "all" {
+		query = query.Where("booking_type = ?", filters.BookingType)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, fmt.Errorf("総件数の取得に失敗しました: %w", err)
+	}
+
+	var bookings []models.Booking
+	if err := query.
+		Preload("BookingOptions").
+		Preload("BookingOptions.Option").
+		Order("start_time DESC").
+		Offset(offset).Limit(limit).
+		Find(&bookings).Error; err != nil {
+		return nil, fmt.Errorf("予約履歴の取得に失敗しました: %w", err)
+	}
+
+	bookingResponses := make([]BookingResponse, len(bookings))
+	for i, booking := range bookings {
+		bookingResponses[i] = convertToBookingResponse(booking)
+	}
+
+	return &UserBookingListResponse{
+		Bookings:    bookingResponses,
+		TotalCount:  int(totalCount),
+		Limit:       limit,
+		Offset:      offset,
+		HasNextPage: totalCount > int64(offset+limit),
+	}, nil
+}
+
+// GetBookingByID 予約詳細取得
+// 2番目の戻り値は予約がユーザー自身のものかどうかを表す
+func (s *UserBookingServiceImpl) GetBookingByID(bookingID, userID string) (*BookingResponse, bool, error) {
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return nil, false, ErrBookingNotFound
+	}
+
+	var booking models.Booking
+	if err := s.db.Preload("User").
+		Preload("BookingOptions").
+		Preload("BookingOptions.Option").
+		First(&booking, "id = ?", bookingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, ErrBookingNotFound
+		}
+		return nil, false, fmt.Errorf("予約の取得に失敗しました: %w", err)
+	}
+
+	isOwn := booking.UserID != nil && booking.UserID.String() == userID
+	response := convertToBookingResponse(booking)
+	return &response, isOwn, nil
+}
+
+// CancelBooking ユーザーによる予約キャンセル
+func (s *UserBookingServiceImpl) CancelBooking(bookingID, userID, reason string) (*BookingResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザーIDが無効です: %w", err)
+	}
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return nil, ErrBookingNotFound
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var booking models.Booking
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&booking, "id = ?", bookingID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrBookingNotFound
+			}
+			return fmt.Errorf("予約の確認に失敗しました: %w", err)
+		}
+
+		if booking.UserID == nil || *booking.UserID != userUUID {
+			return ErrNotBookingOwner
+		}
+
+		// キャンセル料の記録とキープの繰り上げは状態遷移のフックで行う
+		_, err := s.states.Transition(tx, BookingTransition{
+			Booking:   booking,
+			To:        models.BookingState{Status: models.BookingStatusCancelled},
+			Actor:     BookingActorUser,
+			ChangedBy: &userUUID,
+			Note:      "ユーザーによる予約キャンセル",
+			Reason:    reason,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, _, err := s.GetBookingByID(bookingID, userID)
+	return response, err
+}
+
+// QuoteCancellation キャンセル料の見積もり
+func (s *UserBookingServiceImpl) QuoteCancellation(bookingID, userID string) (*CancellationQuote, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザーIDが無効です: %w", err)
+	}
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return nil, ErrBookingNotFound
+	}
+
+	var booking models.Booking
+	if err := s.db.First(&booking, "id = ?", bookingID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBookingNotFound
+		}
+		return nil, fmt.Errorf("予約の確認に失敗しました: %w", err)
+	}
+
+	if booking.UserID == nil || *booking.UserID != userUUID {
+		return nil, ErrNotBookingOwner
+	}
+	if !isActiveStatus(booking.Status) {
+		return nil, ErrBookingNotCancellable
+	}
+
+	quote, err := quoteCancellation(s.cancellationPolicy, s.pricing, booking, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &quote, nil
+}
+
+// ConfirmBooking 仮予約から本予約への変更
+// 承認済みかつ第一予約の仮予約のみ、確認期限内に限り変更できる
+func (s *UserBookingServiceImpl) ConfirmBooking(bookingID, userID string) (*BookingResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザーIDが無効です: %w", err)
+	}
+	if _, err := uuid.Parse(bookingID); err != nil {
+		return nil, ErrBookingNotFound
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var booking models.Booking
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&booking, "id = ?", bookingID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrBookingNotFound
+			}
+			return fmt.Errorf("予約の確認に失敗しました: %w", err)
+		}
+
+		if booking.UserID == nil || *booking.UserID != userUUID {
+			return ErrNotBookingOwner
+		}
+
+		if booking.BookingType != models.BookingTypeTemporary {
+			return ErrNotTemporaryBooking
+		}
+
+		// 承認済み・確認期限内・第一予約であることは状態遷移のガード条件で検証する
+		_, err := s.states.Transition(tx, BookingTransition{
+			Booking:   booking,
+			To:        models.BookingState{Type: models.BookingTypeConfirmed},
+			Actor:     BookingActorUser,
+			ChangedBy: &userUUID,
+			Note:      "仮予約から本予約へ変更",
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, _, err := s.GetBookingByID(bookingID, userID)
+	return response, err
+}
+
+// createBookingOption 有効なオプションを検証して予約オプションを追加
+func createBookingOption(tx *gorm.DB, bookingID uuid.UUID, selected SelectedOptionRequest) error {
+	optionID, err := uuid.Parse(selected.OptionID)
+	if err != nil {
+		return ErrInvalidOption.WithDetails(map[string]interface{}{"optionId": selected.OptionID})
+	}
+
+	quantity := selected.Quantity
+	if quantity <= 0 {
+		quantity = 1
+	}
+
+	var option models.Option
+	if err := tx.First(&option, "id = ? AND is_active = ?", optionID, true).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidOption.WithDetails(map[string]interface{}{"optionId": selected.OptionID})
+		}
+		return fmt.Errorf("オプションの確認に失敗しました: %w", err)
+	}
+
+	bookingOption := models.BookingOption{
+		ID:        uuid.New(),
+		BookingID: bookingID,
+		OptionID:  optionID,
+		Quantity:  quantity,
+		Price:     option.UnitPrice * quantity,
+	}
+	if err := tx.Create(&bookingOption).Error; err != nil {
+		return fmt.Errorf("オプションの追加に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// コントローラーと共有するリクエスト・レスポンス型
+type UserCreateBookingRequest struct {
+	StartTime   time.Time               `json:"startTime" validate:"required"`
+	EndTime     time.Time               `json:"endTime" validate:"required"`
+	BookingType string                  `json:"bookingType" validate:"required,oneof=temporary confirmed"`
+	Purpose     string                  `json:"purpose,omitempty"`
+	PeopleCount int                     `json:"peopleCount,omitempty"`
+	Options     []SelectedOptionRequest `json:"selectedOptions,omitempty"`
+}
+
+type SelectedOptionRequest struct {
+	OptionID string  `json:"optionId"`
+	Quantity float64 `json:"quantity"`
+}
+
+type CancelBookingRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type UserBookingFilters struct {
+	Status      string `query:"status"`
+	BookingType string `query:"bookingType"`
+}
+
+type UserBookingListResponse struct {
+	Bookings    []BookingResponse `json:"bookings"`
+	TotalCount  int               `json:"totalCount"`
+	Limit       int               `json:"limit"`
+	Offset      int               `json:"offset"`
+	HasNextPage bool              `json:"hasNextPage"`
+}
@@ -0,0 +1,201 @@
+package validation
+
+import (
+	"strings"
+	"time"
+
+	"github.com/zebraApp/internal/i18n"
+	"github.com/zebraApp/internal/models"
+)
+
+// Code はフロントエンドと共有するエラーコード
+type Code string
+
+const (
+	CodeInvalidDateRange       Code = "INVALID_DATE_RANGE"
+	CodeInvalidBookingInterval Code = "INVALID_BOOKING_INTERVAL"
+	CodeBusinessHoursViolation Code = "BUSINESS_HOURS_VIOLATION"
+	// CodeTemporaryBookingLimitExceeded は1ユーザーの仮予約数の上限（時間帯のキープ数の上限KEEP_LIMIT_EXCEEDEDとは別）
+	CodeTemporaryBookingLimitExceeded Code = "TEMPORARY_BOOKING_LIMIT_EXCEEDED"
+)
+
+// 業務ルールの識別子
+const (
+	RuleDateRange             = "date_range"
+	RulePastDate              = "past_date"
+	RuleMinimumDuration       = "minimum_duration"
+	RuleBookingInterval       = "booking_interval"
+	RuleBusinessHours         = "business_hours"
+	RuleMaxAdvance            = "max_advance"
+	RuleTemporaryBookingLimit = "temporary_booking_limit"
+)
+
+// MinimumIntervalMinutes は設定できる予約間隔の最小値（分）
+const MinimumIntervalMinutes = 30
+
+// Violation は業務ルール違反1件
+type Violation struct {
+	Rule    string `json:"rule"`
+	Code    Code   `json:"code"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+
+	// key・args はメッセージをレスポンスの言語で作り直すためのi18nのキーと引数
+	key  string
+	args []interface{}
+}
+
+// MessageKey メッセージのi18nキー
+func (v Violation) MessageKey() string {
+	return v.key
+}
+
+// MessageArgs メッセージの引数
+func (v Violation) MessageArgs() []interface{} {
+	return v.args
+}
+
+// Violations は業務ルール違反の一覧
+type Violations []Violation
+
+// Localize メッセージを指定言語にした一覧を返す
+func (vs Violations) Localize(lang i18n.Lang) Violations {
+	localized := make(Violations, len(vs))
+	for i, v := range vs {
+		if v.key != "" {
+			v.Message = i18n.Message(lang, v.key, v.args...)
+		}
+		localized[i] = v
+	}
+	return localized
+}
+
+// Error は業務ルール違反の一覧を表すエラー
+type Error struct {
+	Violations Violations
+}
+
+func (e *Error) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.Message
+	}
+	return "booking rule violation: " + strings.Join(messages, " / ")
+}
+
+// Rules は予約の業務ルールの設定
+type Rules struct {
+	MinimumDuration      time.Duration  // 最低利用時間
+	IntervalMinutes      int            // 開始・終了時刻の刻み（分）
+	MaxAdvanceDays       int            // 何日先まで予約できるか
+	MaxTemporaryBookings int            // 1ユーザーが同時に持てる仮予約の上限（0は無制限）
+	Location             *time.Location // 時刻の刻みを判定するタイムゾーン
+}
+
+// BusinessHours は営業時間の判定（schedule.BusinessCalendarが実装する）
+type BusinessHours interface {
+	Contains(startTime, endTime time.Time) bool
+}
+
+// Booking は検証対象の予約内容
+type Booking struct {
+	StartTime   time.Time
+	EndTime     time.Time
+	BookingType models.BookingType
+	// ActiveTemporaryBookings は申請者の有効な仮予約の件数（検証対象の予約を除く）
+	ActiveTemporaryBookings int
+	// OverrideRules は管理者が業務ルールの検証の省略を指定したことを表す（時間の前後関係のみ検証する）
+	OverrideRules bool
+}
+
+// Validator は予約の業務ルールを検証する
+type Validator struct {
+	rules         Rules
+	businessHours BusinessHours
+}
+
+// NewValidator 業務ルールの検証を作成する。businessHoursがnilの場合は営業時間を検証しない
+func NewValidator(rules Rules, businessHours BusinessHours) *Validator {
+	return &Validator{rules: rules, businessHours: businessHours}
+}
+
+// Validate 予約内容を検証し、違反があれば*Errorを返す
+func (v *Validator) Validate(booking Booking, now time.Time) error {
+	violations := v.Violations(booking, now)
+	if len(violations) > 0 {
+		return &Error{Violations: violations}
+	}
+	return nil
+}
+
+// Violations 予約内容の業務ルール違反をすべて返す
+func (v *Validator) Violations(booking Booking, now time.Time) Violations {
+	var violations Violations
+	add := func(rule string, code Code, field, key string, args ...interface{}) {
+		violations = append(violations, Violation{
+			Rule:    rule,
+			Code:    code,
+			Field:   field,
+			Message: i18n.Message(i18n.Default, key, args...),
+			key:     key,
+			args:    args,
+		})
+	}
+
+	// 時間の前後関係が不正な場合は他のルールを検証しない
+	if !booking.EndTime.After(booking.StartTime) {
+		add(RuleDateRange, CodeInvalidDateRange, "endTime", "validation.date_range")
+		return violations
+	}
+	if booking.OverrideRules {
+		return violations
+	}
+
+	if !booking.StartTime.After(now) {
+		add(RulePastDate, CodeInvalidDateRange, "startTime", "validation.past_date")
+	}
+
+	if v.rules.MaxAdvanceDays > 0 {
+		limit := now.AddDate(0, 0, v.rules.MaxAdvanceDays)
+		if booking.StartTime.After(limit) {
+			add(RuleMaxAdvance, CodeInvalidDateRange, "startTime", "validation.max_advance", v.rules.MaxAdvanceDays)
+		}
+	}
+
+	if v.rules.MinimumDuration > 0 && booking.EndTime.Sub(booking.StartTime) < v.rules.MinimumDuration {
+		add(RuleMinimumDuration, CodeInvalidDateRange, "endTime", "validation.minimum_duration", v.rules.MinimumDuration.Hours())
+	}
+
+	if v.rules.IntervalMinutes > 0 {
+		if !v.onInterval(booking.StartTime) {
+			add(RuleBookingInterval, CodeInvalidBookingInterval, "startTime", "validation.booking_interval_start", v.rules.IntervalMinutes)
+		}
+		if !v.onInterval(booking.EndTime) {
+			add(RuleBookingInterval, CodeInvalidBookingInterval, "endTime", "validation.booking_interval_end", v.rules.IntervalMinutes)
+		}
+	}
+
+	if v.businessHours != nil && !v.businessHours.Contains(booking.StartTime, booking.EndTime) {
+		add(RuleBusinessHours, CodeBusinessHoursViolation, "startTime", "validation.business_hours")
+	}
+
+	if booking.BookingType == models.BookingTypeTemporary && v.rules.MaxTemporaryBookings > 0 &&
+		booking.ActiveTemporaryBookings >= v.rules.MaxTemporaryBookings {
+		add(RuleTemporaryBookingLimit, CodeTemporaryBookingLimitExceeded, "bookingType", "validation.temporary_booking_limit", v.rules.MaxTemporaryBookings)
+	}
+
+	return violations
+}
+
+// onInterval 時刻が予約間隔の刻みに一致するかどうか
+func (v *Validator) onInterval(t time.Time) bool {
+	loc := v.rules.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	t = t.In(loc)
+	if t.Second() != 0 || t.Nanosecond() != 0 {
+		return false
+	}
+	return (t.Hour()*60+t.Minute())%v.rules.IntervalMinutes == 0
+}
@@ -0,0 +1,120 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zebraApp/internal/models"
+)
+
+var testLocation = time.FixedZone("JST", 9*60*60)
+
+// fixedHours は毎日open時〜close時を営業時間とする
+type fixedHours struct {
+	open, close int
+}
+
+func (h fixedHours) Contains(startTime, endTime time.Time) bool {
+	startTime, endTime = startTime.In(testLocation), endTime.In(testLocation)
+	y, m, d := startTime.Date()
+	open := time.Date(y, m, d, h.open, 0, 0, 0, testLocation)
+	close := time.Date(y, m, d, h.close, 0, 0, 0, testLocation)
+	return !startTime.Before(open) && !endTime.After(close)
+}
+
+func TestValidatorViolations(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, testLocation)
+	day := time.Date(2025, 4, 10, 0, 0, 0, 0, testLocation)
+	at := func(hour, minute int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+
+	rules := Rules{
+		MinimumDuration:      time.Hour,
+		IntervalMinutes:      30,
+		MaxAdvanceDays:       30,
+		MaxTemporaryBookings: 2,
+		Location:             testLocation,
+	}
+	validator := NewValidator(rules, fixedHours{open: 9, close: 22})
+
+	tests := []struct {
+		name    string
+		booking Booking
+		want    []string // 違反するルール（検証順）
+	}{
+		{"valid", Booking{StartTime: at(10, 0), EndTime: at(12, 0), BookingType: models.BookingTypeConfirmed}, nil},
+		{"end before start", Booking{StartTime: at(12, 0), EndTime: at(10, 0)}, []string{RuleDateRange}},
+		{"past start", Booking{StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)}, []string{RulePastDate}},
+
+		{"minimum duration exactly", Booking{StartTime: at(10, 0), EndTime: at(11, 0)}, nil},
+		{"shorter than minimum", Booking{StartTime: at(10, 0), EndTime: at(10, 30)}, []string{RuleMinimumDuration}},
+
+		{"start off granularity", Booking{StartTime: at(10, 15), EndTime: at(12, 0)}, []string{RuleBookingInterval}},
+		{"end off granularity", Booking{StartTime: at(10, 0), EndTime: at(11, 45)}, []string{RuleBookingInterval}},
+		{"both off granularity", Booking{StartTime: at(10, 10), EndTime: at(11, 40)}, []string{RuleBookingInterval, RuleBookingInterval}},
+		{"seconds off granularity", Booking{StartTime: at(10, 0).Add(time.Second), EndTime: at(12, 0)}, []string{RuleBookingInterval}},
+
+		{"opening to closing", Booking{StartTime: at(9, 0), EndTime: at(22, 0)}, nil},
+		{"before opening", Booking{StartTime: at(8, 0), EndTime: at(10, 0)}, []string{RuleBusinessHours}},
+		{"past closing", Booking{StartTime: at(21, 0), EndTime: at(23, 0)}, []string{RuleBusinessHours}},
+
+		{"last day of advance window", Booking{StartTime: now.AddDate(0, 0, 30), EndTime: now.AddDate(0, 0, 30).Add(time.Hour)}, nil},
+		{"beyond advance window", Booking{StartTime: now.AddDate(0, 0, 30).Add(30 * time.Minute), EndTime: now.AddDate(0, 0, 30).Add(90 * time.Minute)}, []string{RuleMaxAdvance}},
+
+		{"temporary under limit", Booking{StartTime: at(10, 0), EndTime: at(12, 0), BookingType: models.BookingTypeTemporary, ActiveTemporaryBookings: 1}, nil},
+		{"temporary at limit", Booking{StartTime: at(10, 0), EndTime: at(12, 0), BookingType: models.BookingTypeTemporary, ActiveTemporaryBookings: 2}, []string{RuleTemporaryBookingLimit}},
+		{"confirmed ignores temporary limit", Booking{StartTime: at(10, 0), EndTime: at(12, 0), BookingType: models.BookingTypeConfirmed, ActiveTemporaryBookings: 5}, nil},
+
+		{"override skips rules", Booking{StartTime: at(7, 10), EndTime: at(7, 20), BookingType: models.BookingTypeTemporary, ActiveTemporaryBookings: 5, OverrideRules: true}, nil},
+		{"override keeps date range", Booking{StartTime: at(12, 0), EndTime: at(10, 0), OverrideRules: true}, []string{RuleDateRange}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := validator.Violations(tt.booking, now)
+			if len(violations) != len(tt.want) {
+				t.Fatalf("expected violations %v, got %+v", tt.want, violations)
+			}
+			for i, v := range violations {
+				if v.Rule != tt.want[i] {
+					t.Errorf("violation %d: expected rule %s, got %s", i, tt.want[i], v.Rule)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatorUnlimitedRules(t *testing.T) {
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, testLocation)
+	booking := Booking{
+		StartTime:               now.AddDate(1, 0, 0).Add(7 * time.Minute),
+		EndTime:                 now.AddDate(1, 0, 0).Add(17 * time.Minute),
+		BookingType:             models.BookingTypeTemporary,
+		ActiveTemporaryBookings: 10,
+	}
+	// ゼロ値の設定と営業時間なしでは前後関係と過去日時のみを検証する
+	if err := NewValidator(Rules{}, nil).Validate(booking, now); err != nil {
+		t.Fatalf("expected no violations, got %v", err)
+	}
+}
+
+func TestTemporaryBookingLimitCode(t *testing.T) {
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, testLocation)
+	validator := NewValidator(Rules{MaxTemporaryBookings: 1}, nil)
+	err := validator.Validate(Booking{
+		StartTime:               now.Add(24 * time.Hour),
+		EndTime:                 now.Add(26 * time.Hour),
+		BookingType:             models.BookingTypeTemporary,
+		ActiveTemporaryBookings: 1,
+	}, now)
+
+	var violation *Error
+	if !errors.As(err, &violation) || len(violation.Violations) != 1 {
+		t.Fatalf("expected a single violation, got %v", err)
+	}
+	if got := violation.Violations[0].Code; got != CodeTemporaryBookingLimitExceeded {
+		t.Fatalf("expected code %s, got %s", CodeTemporaryBookingLimitExceeded, got)
+	}
+}
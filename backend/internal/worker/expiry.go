@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// BookingExpirer は期限切れ仮予約を自動キャンセルする処理
+type BookingExpirer interface {
+	ExpireOverdueBookings(now time.Time) ([]string, error)
+}
+
+// ExpiryWorker は一定間隔で期限切れ仮予約の自動キャンセルを実行する
+type ExpiryWorker struct {
+	expirer  BookingExpirer
+	interval time.Duration
+}
+
+func NewExpiryWorker(expirer BookingExpirer, interval time.Duration) *ExpiryWorker {
+	return &ExpiryWorker{
+		expirer:  expirer,
+		interval: interval,
+	}
+}
+
+// Run ctxがキャンセルされるまで定期実行する
+// 起動直後にも1回実行し、停止中に溜まった期限切れを処理する
+func (w *ExpiryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	w.runOnce()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.runOnce()
+		}
+	}
+}
+
+func (w *ExpiryWorker) runOnce() {
+	expired, err := w.expirer.ExpireOverdueBookings(time.Now())
+	if len(expired) > 0 {
+		log.Printf("期限切れ仮予約を自動キャンセルしました: %d件 %v", len(expired), expired)
+	}
+	if err != nil {
+		log.Printf("期限切れ仮予約の自動キャンセルに失敗しました: %v", err)
+	}
+}
@@ -0,0 +1,3 @@
+-- 予約の作成者・更新者カラムを削除
+ALTER TABLE bookings DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE bookings DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,3 @@
+-- 予約の作成者・更新者カラムを追加
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS created_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS updated_by UUID REFERENCES users(id) ON DELETE SET NULL;
@@ -0,0 +1,6 @@
+-- インデックスを削除
+DROP INDEX IF EXISTS idx_refresh_tokens_expires_at;
+DROP INDEX IF EXISTS idx_refresh_tokens_user_id;
+
+-- テーブルを削除
+DROP TABLE IF EXISTS refresh_tokens;
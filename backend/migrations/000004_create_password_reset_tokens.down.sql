@@ -0,0 +1,5 @@
+-- インデックスを削除
+DROP INDEX IF EXISTS idx_password_reset_tokens_user_id;
+
+-- テーブルを削除
+DROP TABLE IF EXISTS password_reset_tokens;
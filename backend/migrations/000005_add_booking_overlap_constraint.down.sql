@@ -0,0 +1,6 @@
+-- 制約を削除
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_time_range_check;
+
+-- 拡張機能を削除（必要な場合のみ）
+-- DROP EXTENSION IF EXISTS btree_gist;
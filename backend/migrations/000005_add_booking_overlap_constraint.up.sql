@@ -0,0 +1,12 @@
+-- 予約時間帯の重複をデータベースレベルで防止する
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- 終了時刻は開始時刻より後でなければならない
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_time_range_check CHECK (end_time > start_time);
+
+-- 有効な予約（申請中・承認済み）同士の時間帯の重複を禁止する
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_no_overlap
+    EXCLUDE USING gist (tstzrange(start_time, end_time, '[)') WITH &&)
+    WHERE (status IN ('pending', 'approved'));
@@ -0,0 +1,9 @@
+-- 重複禁止制約を元に戻す
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_no_overlap
+    EXCLUDE USING gist (tstzrange(start_time, end_time, '[)') WITH &&)
+    WHERE (status IN ('pending', 'approved'));
+
+-- キープ順序カラムを削除
+ALTER TABLE bookings DROP COLUMN IF EXISTS keep_order;
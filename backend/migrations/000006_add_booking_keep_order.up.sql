@@ -0,0 +1,12 @@
+-- キープシステム（第一予約〜第三キープ）のための順序カラムを追加
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS keep_order INT NOT NULL DEFAULT 1
+    CHECK (keep_order BETWEEN 1 AND 3);
+
+-- 重複禁止制約をキープ順序単位に置き換える
+-- 同じ時間帯に重なる有効な予約は、それぞれ異なるキープ順序を持たなければならない
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings
+    ADD CONSTRAINT bookings_no_overlap
+    EXCLUDE USING gist (tstzrange(start_time, end_time, '[)') WITH &&, keep_order WITH =)
+    WHERE (status IN ('pending', 'approved'));
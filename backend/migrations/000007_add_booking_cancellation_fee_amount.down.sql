@@ -0,0 +1 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS cancellation_fee_amount;
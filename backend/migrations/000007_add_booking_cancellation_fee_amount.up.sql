@@ -0,0 +1,3 @@
+-- キャンセル時に適用したキャンセル料（円）を保持するカラムを追加
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS cancellation_fee_amount INT NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS price_breakdown,
+    DROP COLUMN IF EXISTS total_amount_including_tax,
+    DROP COLUMN IF EXISTS tax_amount,
+    DROP COLUMN IF EXISTS subtotal_amount;
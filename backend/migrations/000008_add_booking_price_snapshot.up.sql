@@ -0,0 +1,6 @@
+-- 予約時点の料金（税抜小計・消費税・税込合計）と内訳のスナップショット
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS subtotal_amount INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tax_amount INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS total_amount_including_tax INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS price_breakdown JSONB;
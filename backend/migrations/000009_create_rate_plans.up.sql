@@ -0,0 +1,18 @@
+-- 曜日・時間帯別の料金プランテーブル
+-- どのプランにも該当しない時間は基本料金（HOURLY_RATE）で計算する
+CREATE TABLE IF NOT EXISTS rate_plans (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    weekday_mask SMALLINT NOT NULL CHECK (weekday_mask BETWEEN 0 AND 127),
+    applies_to_holidays BOOLEAN DEFAULT FALSE,
+    start_minute INT NOT NULL CHECK (start_minute BETWEEN 0 AND 1439),
+    end_minute INT NOT NULL CHECK (end_minute BETWEEN 1 AND 1440),
+    hourly_rate INT NOT NULL CHECK (hourly_rate >= 0),
+    priority INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT rate_plans_minute_range_check CHECK (end_minute > start_minute)
+);
+
+CREATE INDEX idx_rate_plans_is_active ON rate_plans(is_active);
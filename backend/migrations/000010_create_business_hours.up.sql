@@ -0,0 +1,34 @@
+-- 営業時間設定テーブル
+-- weekly: 曜日ごとの通常営業時間、date: 特定日の例外、holiday: 祝日の営業時間
+-- 優先順位は date > holiday > weekly で、設定のない曜日は休業日として扱う
+CREATE TABLE IF NOT EXISTS business_hours (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('weekly', 'date', 'holiday')),
+    weekday SMALLINT CHECK (weekday BETWEEN 0 AND 6),
+    date DATE,
+    is_closed BOOLEAN DEFAULT FALSE,
+    open_minute INT CHECK (open_minute BETWEEN 0 AND 1439),
+    close_minute INT CHECK (close_minute BETWEEN 1 AND 1440),
+    note VARCHAR(200),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT business_hours_weekday_check CHECK ((kind = 'weekly') = (weekday IS NOT NULL)),
+    CONSTRAINT business_hours_date_check CHECK ((kind = 'date') = (date IS NOT NULL)),
+    CONSTRAINT business_hours_minute_range_check CHECK (
+        is_closed OR (open_minute IS NOT NULL AND close_minute IS NOT NULL AND close_minute > open_minute)
+    )
+);
+
+CREATE UNIQUE INDEX idx_business_hours_weekday ON business_hours(weekday) WHERE kind = 'weekly';
+CREATE UNIQUE INDEX idx_business_hours_date ON business_hours(date) WHERE kind = 'date';
+CREATE UNIQUE INDEX idx_business_hours_holiday ON business_hours(kind) WHERE kind = 'holiday';
+
+-- 従来の営業時間（平日9:00〜22:00、土日休業）を初期値とする
+INSERT INTO business_hours (kind, weekday, is_closed, open_minute, close_minute, note) VALUES
+    ('weekly', 0, TRUE, NULL, NULL, '定休日'),
+    ('weekly', 1, FALSE, 540, 1320, ''),
+    ('weekly', 2, FALSE, 540, 1320, ''),
+    ('weekly', 3, FALSE, 540, 1320, ''),
+    ('weekly', 4, FALSE, 540, 1320, ''),
+    ('weekly', 5, FALSE, 540, 1320, ''),
+    ('weekly', 6, TRUE, NULL, NULL, '定休日');
@@ -0,0 +1,40 @@
+-- 顧客からの予約変更申請テーブル
+-- 申請中は希望する時間帯を仮押さえし、管理者が承認すると予約の時間帯・オプションを置き換える
+CREATE TABLE IF NOT EXISTS booking_change_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    requested_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'cancelled')),
+    -- 申請時点の予約の時間帯
+    previous_start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    previous_end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    -- 希望する時間帯（時間帯を変更しない場合はNULL）
+    start_time TIMESTAMP WITH TIME ZONE,
+    end_time TIMESTAMP WITH TIME ZONE,
+    -- 希望するオプション（オプションを変更しない場合はNULL）
+    options JSONB,
+    reason TEXT,
+    decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    decision_note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT booking_change_requests_time_range_check CHECK (
+        (start_time IS NULL AND end_time IS NULL) OR end_time > start_time
+    ),
+    CONSTRAINT booking_change_requests_content_check CHECK (start_time IS NOT NULL OR options IS NOT NULL)
+);
+
+CREATE INDEX idx_booking_change_requests_booking_id ON booking_change_requests(booking_id);
+CREATE INDEX idx_booking_change_requests_status ON booking_change_requests(status);
+
+-- 1つの予約に対する申請中の変更は1件まで
+CREATE UNIQUE INDEX idx_booking_change_requests_pending ON booking_change_requests(booking_id)
+    WHERE status = 'pending';
+
+-- 申請中の仮押さえ同士の時間帯の重複を禁止する
+-- 予約との重複はキープ順序の割り当て時にアプリケーションで確認する
+ALTER TABLE booking_change_requests
+    ADD CONSTRAINT booking_change_requests_no_overlap
+    EXCLUDE USING gist (tstzrange(start_time, end_time, '[)') WITH &&)
+    WHERE (status = 'pending' AND start_time IS NOT NULL);
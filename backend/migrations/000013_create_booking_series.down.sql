@@ -0,0 +1,6 @@
+-- 予約とシリーズの関連カラムを削除
+DROP INDEX IF EXISTS idx_bookings_series_id;
+ALTER TABLE bookings DROP COLUMN IF EXISTS occurrence_date;
+ALTER TABLE bookings DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS booking_series;
@@ -0,0 +1,33 @@
+-- 定期予約（シリーズ）テーブル
+-- 繰り返しの規則はRRULEに倣い、作成時に各回の予約をbookingsに登録する
+CREATE TABLE IF NOT EXISTS booking_series (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    frequency VARCHAR(20) NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly')),
+    repeat_interval INT NOT NULL DEFAULT 1 CHECK (repeat_interval >= 1),
+    -- 繰り返しの回数と最終日（少なくとも一方を指定する）
+    occurrence_count INT CHECK (occurrence_count >= 1),
+    until_date DATE,
+    -- 除外する日付（YYYY-MM-DDの配列）
+    exception_dates JSONB NOT NULL DEFAULT '[]',
+    -- 初回の日時。各回は同じ時刻・同じ長さで繰り返す
+    start_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    booking_type VARCHAR(20) NOT NULL CHECK (booking_type IN ('temporary', 'confirmed')),
+    purpose TEXT,
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT booking_series_time_range_check CHECK (end_time > start_time),
+    CONSTRAINT booking_series_end_check CHECK (occurrence_count IS NOT NULL OR until_date IS NOT NULL)
+);
+
+CREATE INDEX idx_booking_series_user_id ON booking_series(user_id);
+
+-- 予約とシリーズの関連。occurrence_dateは規則上の回の日付で、日時を変更しても変わらない
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES booking_series(id) ON DELETE SET NULL;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS occurrence_date DATE;
+
+CREATE INDEX idx_bookings_series_id ON bookings(series_id, occurrence_date);
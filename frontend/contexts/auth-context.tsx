@@ -237,15 +237,12 @@ export function AuthProvider({ children }: { children: React.ReactNode }) {
   // パスワードリセット
   const resetPassword = async (email: string) => {
     try {
-      const response = await fetch(`${API_BASE_URL}/auth/password-reset`, {
+      const response = await fetch(`${API_BASE_URL}/auth/forgot-password`, {
         method: 'POST',
         headers: {
           'Content-Type': 'application/json',
         },
-        body: JSON.stringify({ 
-          action: 'request',
-          email 
-        }),
+        body: JSON.stringify({ email }),
       });
 
       if (!response.ok) {